@@ -0,0 +1,243 @@
+package ds
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"iter"
+	"math/bits"
+)
+
+var ErrBitSetData = errors.New("invalid bit set data")
+
+var (
+	_ encoding.BinaryMarshaler   = (*BitSet)(nil)
+	_ encoding.BinaryUnmarshaler = (*BitSet)(nil)
+)
+
+// BitSet is a set of non-negative integers stored one bit each. It grows as
+// bits are set. The zero value is an empty set. Passing a negative index to
+// any method panics.
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet returns an empty set with room for the bits 0..n-1.
+func NewBitSet(n int) *BitSet {
+	return &BitSet{words: make([]uint64, 0, (max(n, 0)+63)/64)}
+}
+
+func checkBit(i int) {
+	if i < 0 {
+		panic(fmt.Errorf("%w [%d]", ErrIndexOutOfRange, i))
+	}
+}
+
+// grow makes room for n words.
+func (b *BitSet) grow(n int) {
+	if n > len(b.words) {
+		b.words = append(b.words, make([]uint64, n-len(b.words))...)
+	}
+}
+
+func (b *BitSet) Set(i int) {
+	checkBit(i)
+	b.grow(i/64 + 1)
+	b.words[i/64] |= 1 << (i % 64)
+}
+
+func (b *BitSet) Clear(i int) {
+	checkBit(i)
+	if i/64 < len(b.words) {
+		b.words[i/64] &^= 1 << (i % 64)
+	}
+}
+
+func (b *BitSet) Test(i int) bool {
+	checkBit(i)
+	return i/64 < len(b.words) && b.words[i/64]&(1<<(i%64)) != 0
+}
+
+// Flip toggles bit i and returns its new value.
+func (b *BitSet) Flip(i int) bool {
+	checkBit(i)
+	b.grow(i/64 + 1)
+	b.words[i/64] ^= 1 << (i % 64)
+	return b.words[i/64]&(1<<(i%64)) != 0
+}
+
+// Count returns the number of set bits.
+func (b *BitSet) Count() int {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Rank returns the number of set bits below i in O(i/64).
+func (b *BitSet) Rank(i int) int {
+	checkBit(i)
+	n := 0
+	for j, w := range b.words {
+		if j == i/64 {
+			return n + bits.OnesCount64(w&(1<<(i%64)-1))
+		}
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Select returns the set bit with rank k, the k-th counting from zero, or
+// false if fewer than k+1 bits are set. It takes O(n/64) for n bits.
+func (b *BitSet) Select(k int) (int, bool) {
+	if k < 0 {
+		return 0, false
+	}
+	for j, w := range b.words {
+		c := bits.OnesCount64(w)
+		if k >= c {
+			k -= c
+			continue
+		}
+		for range k {
+			w &= w - 1
+		}
+		return j*64 + bits.TrailingZeros64(w), true
+	}
+	return 0, false
+}
+
+// NextSet returns the first set bit at or after i, or false if there is
+// none.
+func (b *BitSet) NextSet(i int) (int, bool) {
+	checkBit(i)
+	j := i / 64
+	if j >= len(b.words) {
+		return 0, false
+	}
+	if w := b.words[j] >> (i % 64); w != 0 {
+		return i + bits.TrailingZeros64(w), true
+	}
+	for j++; j < len(b.words); j++ {
+		if b.words[j] != 0 {
+			return j*64 + bits.TrailingZeros64(b.words[j]), true
+		}
+	}
+	return 0, false
+}
+
+// All yields the set bits in ascending order.
+func (b *BitSet) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for j, w := range b.words {
+			for w != 0 {
+				if !yield(j*64 + bits.TrailingZeros64(w)) {
+					return
+				}
+				w &= w - 1
+			}
+		}
+	}
+}
+
+func (b *BitSet) String() string {
+	return formatList("BitSet", b.Count(), func(yield func(int, int) bool) {
+		i := 0
+		for v := range b.All() {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	})
+}
+
+// Reset clears every bit.
+func (b *BitSet) Reset() {
+	clear(b.words)
+}
+
+func (b *BitSet) Clone() *BitSet {
+	return &BitSet{words: append([]uint64(nil), b.words...)}
+}
+
+// Equal reports whether b and o have the same bits set.
+func (b *BitSet) Equal(o *BitSet) bool {
+	short, long := b.words, o.words
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	for i, w := range short {
+		if w != long[i] {
+			return false
+		}
+	}
+	for _, w := range long[len(short):] {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// And keeps the bits of b that are also set in o.
+func (b *BitSet) And(o *BitSet) {
+	for i := range b.words {
+		if i < len(o.words) {
+			b.words[i] &= o.words[i]
+		} else {
+			b.words[i] = 0
+		}
+	}
+}
+
+// Or sets the bits of b that are set in o.
+func (b *BitSet) Or(o *BitSet) {
+	b.grow(len(o.words))
+	for i, w := range o.words {
+		b.words[i] |= w
+	}
+}
+
+// Xor flips the bits of b that are set in o.
+func (b *BitSet) Xor(o *BitSet) {
+	b.grow(len(o.words))
+	for i, w := range o.words {
+		b.words[i] ^= w
+	}
+}
+
+// AndNot clears the bits of b that are set in o.
+func (b *BitSet) AndNot(o *BitSet) {
+	for i := range min(len(b.words), len(o.words)) {
+		b.words[i] &^= o.words[i]
+	}
+}
+
+// MarshalBinary encodes the set as big-endian 64-bit words, leaving out the
+// trailing zero words.
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n--
+	}
+	data := make([]byte, 0, n*8)
+	for _, w := range b.words[:n] {
+		data = binary.BigEndian.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	if len(data)%8 != 0 {
+		return fmt.Errorf("%w: length %d is not a multiple of 8", ErrBitSetData, len(data))
+	}
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.BigEndian.Uint64(data[i*8:])
+	}
+	b.words = words
+	return nil
+}
@@ -0,0 +1,240 @@
+package ds
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestBitSet(t *testing.T) {
+	var b BitSet
+	for _, i := range []int{0, 3, 63, 64, 200} {
+		b.Set(i)
+	}
+	b.Set(3)
+	if got := slices.Collect(b.All()); !slices.Equal(got, []int{0, 3, 63, 64, 200}) {
+		t.Errorf("All() = %v, want [0 3 63 64 200]", got)
+	}
+	if b.Count() != 5 || !b.Test(63) || b.Test(62) || b.Test(1000) {
+		t.Errorf("Count(), Test() of %v are wrong", &b)
+	}
+
+	b.Clear(63)
+	b.Clear(5000)
+	if b.Flip(1) != true || b.Flip(0) != false {
+		t.Error("Flip(1), Flip(0), want true then false")
+	}
+	if got := b.String(); got != "BitSet{ 1 3 64 200 }" {
+		t.Errorf("String() = %q, want %q", got, "BitSet{ 1 3 64 200 }")
+	}
+
+	b.Reset()
+	if b.Count() != 0 || b.String() != "BitSet{  }" {
+		t.Errorf("Reset() left %v", &b)
+	}
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Set(-1) panicked with %v, want ErrIndexOutOfRange", err)
+		}
+	}()
+	b.Set(-1)
+}
+
+func TestBitSetRankSelect(t *testing.T) {
+	b := NewBitSet(300)
+	ids := []int{2, 5, 64, 65, 127, 128, 299}
+	for _, i := range ids {
+		b.Set(i)
+	}
+
+	tests := []struct {
+		i, rank int
+	}{
+		{0, 0}, {2, 0}, {3, 1}, {64, 2}, {66, 4}, {128, 5}, {129, 6}, {299, 6}, {300, 7}, {10000, 7},
+	}
+	for _, tt := range tests {
+		if got := b.Rank(tt.i); got != tt.rank {
+			t.Errorf("Rank(%d) = %d, want %d", tt.i, got, tt.rank)
+		}
+	}
+	for k, want := range ids {
+		if got, ok := b.Select(k); !ok || got != want {
+			t.Errorf("Select(%d) = %d, %v, want %d", k, got, ok, want)
+		}
+	}
+	for _, k := range []int{-1, len(ids)} {
+		if _, ok := b.Select(k); ok {
+			t.Errorf("Select(%d) found a bit", k)
+		}
+	}
+
+	next := []struct {
+		i, want int
+		ok      bool
+	}{
+		{0, 2, true}, {2, 2, true}, {6, 64, true}, {66, 127, true}, {128, 128, true}, {130, 299, true}, {300, 0, false}, {9999, 0, false},
+	}
+	for _, tt := range next {
+		if got, ok := b.NextSet(tt.i); got != tt.want || ok != tt.ok {
+			t.Errorf("NextSet(%d) = %d, %v, want %d, %v", tt.i, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestBitSetBulk(t *testing.T) {
+	newBits := func(ids ...int) *BitSet {
+		b := &BitSet{}
+		for _, i := range ids {
+			b.Set(i)
+		}
+		return b
+	}
+	a := []int{1, 2, 70, 130}
+	o := []int{2, 3, 130, 300}
+
+	tests := []struct {
+		op   string
+		do   func(b, o *BitSet)
+		want []int
+	}{
+		{"And", (*BitSet).And, []int{2, 130}},
+		{"Or", (*BitSet).Or, []int{1, 2, 3, 70, 130, 300}},
+		{"Xor", (*BitSet).Xor, []int{1, 3, 70, 300}},
+		{"AndNot", (*BitSet).AndNot, []int{1, 70}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.op, func(t *testing.T) {
+			for _, swap := range []bool{false, true} {
+				x, y := newBits(a...), newBits(o...)
+				if swap && tt.op != "AndNot" {
+					x, y = y, x
+				}
+				tt.do(x, y)
+				if got := slices.Collect(x.All()); !slices.Equal(got, tt.want) {
+					t.Errorf("%s() = %v, want %v", tt.op, got, tt.want)
+				}
+			}
+		})
+	}
+
+	x := newBits(a...)
+	y := x.Clone()
+	y.Set(1000)
+	y.Clear(1000)
+	if !x.Equal(y) || !y.Equal(x) || x.Equal(newBits(o...)) || x.Equal(&BitSet{}) {
+		t.Error("Equal() results, want x equal to its clone only")
+	}
+}
+
+func TestBitSetModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(51, 52))
+	b := &BitSet{}
+	model := make(map[int]bool)
+	for step := range 2000 {
+		i := r.IntN(500)
+		switch r.IntN(3) {
+		case 0:
+			b.Set(i)
+			model[i] = true
+		case 1:
+			b.Clear(i)
+			delete(model, i)
+		case 2:
+			if b.Flip(i) {
+				model[i] = true
+			} else {
+				delete(model, i)
+			}
+		}
+
+		want := 0
+		for j := range model {
+			if j < i {
+				want++
+			}
+		}
+		if got := b.Rank(i); got != want {
+			t.Fatalf("step %d: Rank(%d) = %d, want %d", step, i, got, want)
+		}
+		if sel, ok := b.Select(want); ok != (b.Count() > want) || ok && (sel < i || !model[sel]) {
+			t.Fatalf("step %d: Select(%d) = %d, %v", step, want, sel, ok)
+		}
+	}
+
+	if b.Count() != len(model) {
+		t.Fatalf("Count() = %d, want %d", b.Count(), len(model))
+	}
+	for i := range b.All() {
+		if !model[i] {
+			t.Fatalf("All() yielded %d, which is not set", i)
+		}
+	}
+}
+
+func TestBitSetBinary(t *testing.T) {
+	b := &BitSet{}
+	b.Set(1)
+	b.Set(100)
+	b.Set(1000)
+	b.Clear(1000)
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() returned %v", err)
+	}
+	if len(data) != 16 {
+		t.Errorf("MarshalBinary() = %d bytes, want 16", len(data))
+	}
+
+	var got BitSet
+	if err := got.UnmarshalBinary(data); err != nil || !got.Equal(b) {
+		t.Errorf("UnmarshalBinary() = %v, %v, want %v", &got, err, b)
+	}
+	if err := got.UnmarshalBinary(data[:5]); !errors.Is(err, ErrBitSetData) {
+		t.Errorf("UnmarshalBinary() of 5 bytes returned %v, want ErrBitSetData", err)
+	}
+	if err := got.UnmarshalBinary(nil); err != nil || got.Count() != 0 {
+		t.Errorf("UnmarshalBinary(nil) = %v, %v, want an empty set", &got, err)
+	}
+}
+
+const bitSetBenchSize = 1 << 16
+
+func BenchmarkBitSetSetTest(b *testing.B) {
+	s := NewBitSet(bitSetBenchSize)
+	for i := 0; b.Loop(); i++ {
+		s.Set(i * 7 % bitSetBenchSize)
+		s.Test(i * 13 % bitSetBenchSize)
+	}
+}
+
+func BenchmarkMapSetTest(b *testing.B) {
+	m := make(map[int]bool, bitSetBenchSize)
+	for i := 0; b.Loop(); i++ {
+		m[i*7%bitSetBenchSize] = true
+		_ = m[i*13%bitSetBenchSize]
+	}
+}
+
+func BenchmarkBitSetIterate(b *testing.B) {
+	s := NewBitSet(bitSetBenchSize)
+	for i := 0; i < bitSetBenchSize; i += 3 {
+		s.Set(i)
+	}
+	for b.Loop() {
+		for range s.All() {
+		}
+	}
+}
+
+func BenchmarkMapIterate(b *testing.B) {
+	m := make(map[int]bool, bitSetBenchSize)
+	for i := 0; i < bitSetBenchSize; i += 3 {
+		m[i] = true
+	}
+	for b.Loop() {
+		for range m {
+		}
+	}
+}
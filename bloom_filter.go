@@ -0,0 +1,183 @@
+package ds
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+)
+
+var (
+	ErrBloomMismatch = errors.New("bloom filters differ in size or number of hashes")
+	ErrBloomData     = errors.New("invalid bloom filter data")
+)
+
+// bloomSize returns the number of bits m and hashes k that keep the false
+// positive rate of a filter holding n items at p.
+func bloomSize(n int, p float64) (m uint64, k int) {
+	n = max(n, 1)
+	p = min(max(p, 1e-12), 0.5)
+	m = uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k = max(int(math.Round(float64(m)/float64(n)*math.Ln2)), 1)
+	return m, k
+}
+
+// bloomIndexes yields the k positions of data among m by double hashing:
+// the i-th position is h1 + i*h2 mod m, with both hashes derived from the
+// FNV-1a sum of data.
+func bloomIndexes(data []byte, m uint64, k int, yield func(uint64) bool) {
+	h := FNV1a64(data)
+	h1, h2 := fmix64(h), fmix64(h^0x9e3779b97f4a7c15)|1
+	for i := range uint64(k) {
+		if !yield((h1 + i*h2) % m) {
+			return
+		}
+	}
+}
+
+// The encoding of a filter starts with its kind, m and k.
+const (
+	bloomKind         = 'B'
+	countingBloomKind = 'C'
+	bloomHeader       = 1 + 8 + 8
+)
+
+func appendBloomHeader(b []byte, kind byte, m uint64, k int) []byte {
+	b = append(b, kind)
+	b = binary.BigEndian.AppendUint64(b, m)
+	return binary.BigEndian.AppendUint64(b, uint64(k))
+}
+
+// readBloomHeader checks the header of data and returns m, k and the rest
+// of data, which must hold size(m) bytes.
+func readBloomHeader(data []byte, kind byte, size func(m uint64) uint64) (uint64, int, []byte, error) {
+	if len(data) < bloomHeader || data[0] != kind {
+		return 0, 0, nil, ErrBloomData
+	}
+	m := binary.BigEndian.Uint64(data[1:])
+	k := binary.BigEndian.Uint64(data[9:])
+	data = data[bloomHeader:]
+	if m == 0 || k == 0 || k > math.MaxInt32 || m > math.MaxInt64/8 || uint64(len(data)) != size(m) {
+		return 0, 0, nil, ErrBloomData
+	}
+	return m, int(k), data, nil
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*BloomFilter)(nil)
+	_ encoding.BinaryUnmarshaler = (*BloomFilter)(nil)
+)
+
+// BloomFilter is a probabilistic set. Contains never misses an added item
+// but may report items that were not added.
+type BloomFilter struct {
+	bits []uint64
+	m    uint64
+	k    int
+}
+
+// NewBloomFilter returns a filter that holds n items with a false positive
+// rate of about p. p is clamped to [1e-12, 0.5].
+func NewBloomFilter(n int, p float64) *BloomFilter {
+	m, k := bloomSize(n, p)
+	return newBloomFilter(m, k)
+}
+
+func newBloomFilter(m uint64, k int) *BloomFilter {
+	return &BloomFilter{bits: make([]uint64, (m+63)/64), m: m, k: k}
+}
+
+// Bits returns the number of bits of the filter.
+func (f *BloomFilter) Bits() int {
+	return int(f.m)
+}
+
+// Hashes returns the number of bits set for every item.
+func (f *BloomFilter) Hashes() int {
+	return f.k
+}
+
+func (f *BloomFilter) Add(data []byte) {
+	bloomIndexes(data, f.m, f.k, func(i uint64) bool {
+		f.bits[i/64] |= 1 << (i % 64)
+		return true
+	})
+}
+
+func (f *BloomFilter) AddString(s string) {
+	f.Add([]byte(s))
+}
+
+func (f *BloomFilter) Contains(data []byte) bool {
+	ok := true
+	bloomIndexes(data, f.m, f.k, func(i uint64) bool {
+		ok = f.bits[i/64]&(1<<(i%64)) != 0
+		return ok
+	})
+	return ok
+}
+
+func (f *BloomFilter) ContainsString(s string) bool {
+	return f.Contains([]byte(s))
+}
+
+// ApproxLen estimates the number of distinct items added from the number
+// of bits set.
+func (f *BloomFilter) ApproxLen() int {
+	set := 0
+	for _, w := range f.bits {
+		set += bits.OnesCount64(w)
+	}
+	if uint64(set) == f.m {
+		return math.MaxInt
+	}
+	return int(math.Round(-float64(f.m) / float64(f.k) * math.Log1p(-float64(set)/float64(f.m))))
+}
+
+func (f *BloomFilter) Clear() {
+	clear(f.bits)
+}
+
+func (f *BloomFilter) Clone() *BloomFilter {
+	c := *f
+	c.bits = append([]uint64(nil), f.bits...)
+	return &c
+}
+
+// Union adds the items of o to f. Both filters must have the same number of
+// bits and hashes.
+func (f *BloomFilter) Union(o *BloomFilter) error {
+	if f.m != o.m || f.k != o.k {
+		return ErrBloomMismatch
+	}
+	for i, w := range o.bits {
+		f.bits[i] |= w
+	}
+	return nil
+}
+
+func (f *BloomFilter) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, bloomHeader+len(f.bits)*8)
+	b = appendBloomHeader(b, bloomKind, f.m, f.k)
+	for _, w := range f.bits {
+		b = binary.BigEndian.AppendUint64(b, w)
+	}
+	return b, nil
+}
+
+func (f *BloomFilter) UnmarshalBinary(data []byte) error {
+	m, k, data, err := readBloomHeader(data, bloomKind, func(m uint64) uint64 { return (m + 63) / 64 * 8 })
+	if err != nil {
+		return err
+	}
+	g := newBloomFilter(m, k)
+	for i := range g.bits {
+		g.bits[i] = binary.BigEndian.Uint64(data[i*8:])
+	}
+	if extra := m % 64; extra != 0 && g.bits[len(g.bits)-1]>>extra != 0 {
+		return ErrBloomData
+	}
+	*f = *g
+	return nil
+}
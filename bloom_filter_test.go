@@ -0,0 +1,163 @@
+package ds
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestBloomSize(t *testing.T) {
+	tests := []struct {
+		n     int
+		p     float64
+		wantM uint64
+		wantK int
+	}{
+		{1000, 0.01, 9586, 7},
+		{1000, 0.001, 14378, 10},
+		{1, 0.5, 2, 1},
+		{0, 0.01, 10, 7},
+		{100, 0, 5752, 40},
+		{100, 1, 145, 1},
+	}
+
+	for _, tt := range tests {
+		if m, k := bloomSize(tt.n, tt.p); m != tt.wantM || k != tt.wantK {
+			t.Errorf("bloomSize(%d, %v) = %d, %d, want %d, %d", tt.n, tt.p, m, k, tt.wantM, tt.wantK)
+		}
+	}
+}
+
+// testFalsePositiveRate adds n items to a filter sized for n items and rate
+// p, and checks that it knows all of them and gives false positives for
+// other items at a rate close to p.
+func testFalsePositiveRate(t *testing.T, n int, p float64, add func(string), contains func(string) bool) {
+	t.Helper()
+	for i := range n {
+		add("member" + strconv.Itoa(i))
+	}
+	for i := range n {
+		if k := "member" + strconv.Itoa(i); !contains(k) {
+			t.Fatalf("Contains(%s) = false after adding it", k)
+		}
+	}
+
+	const probes = 100000
+	fp := 0
+	for i := range probes {
+		if contains("other" + strconv.Itoa(i)) {
+			fp++
+		}
+	}
+	if rate := float64(fp) / probes; rate > 1.5*p || rate < 0.5*p {
+		t.Errorf("false positive rate = %.5f, want about %v", rate, p)
+	}
+}
+
+func TestBloomFilterFalsePositiveRate(t *testing.T) {
+	for _, p := range []float64{0.1, 0.01, 0.001} {
+		t.Run(strconv.FormatFloat(p, 'g', -1, 64), func(t *testing.T) {
+			f := NewBloomFilter(10000, p)
+			testFalsePositiveRate(t, 10000, p, f.AddString, f.ContainsString)
+		})
+	}
+}
+
+func TestBloomFilterUnion(t *testing.T) {
+	a, b := NewBloomFilter(100, 0.01), NewBloomFilter(100, 0.01)
+	a.AddString("a")
+	b.AddString("b")
+	if err := a.Union(b); err != nil {
+		t.Fatalf("Union() returned %v", err)
+	}
+	if !a.ContainsString("a") || !a.ContainsString("b") || b.ContainsString("a") {
+		t.Error("Union() did not add exactly the items of b to a")
+	}
+	if err := a.Union(NewBloomFilter(200, 0.01)); !errors.Is(err, ErrBloomMismatch) {
+		t.Errorf("Union() of filters of other sizes returned %v, want ErrBloomMismatch", err)
+	}
+
+	c := a.Clone()
+	c.Clear()
+	if c.ContainsString("a") || !a.ContainsString("a") {
+		t.Error("Clear() of a clone changed the original or left items")
+	}
+}
+
+func TestBloomFilterApproxLen(t *testing.T) {
+	f := NewBloomFilter(5000, 0.01)
+	if got := f.ApproxLen(); got != 0 {
+		t.Errorf("ApproxLen() of an empty filter = %d, want 0", got)
+	}
+	for i := range 3000 {
+		f.AddString(strconv.Itoa(i))
+		f.AddString(strconv.Itoa(i))
+	}
+	if got := f.ApproxLen(); math.Abs(float64(got)-3000) > 150 {
+		t.Errorf("ApproxLen() = %d, want about 3000", got)
+	}
+}
+
+func TestBloomFilterBinary(t *testing.T) {
+	f := NewBloomFilter(1000, 0.01)
+	for i := range 500 {
+		f.AddString(strconv.Itoa(i))
+	}
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() returned %v", err)
+	}
+
+	var g BloomFilter
+	if err := g.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() returned %v", err)
+	}
+	if g.Bits() != f.Bits() || g.Hashes() != f.Hashes() {
+		t.Errorf("decoded Bits(), Hashes() = %d, %d, want %d, %d", g.Bits(), g.Hashes(), f.Bits(), f.Hashes())
+	}
+	for i := range 1000 {
+		if k := strconv.Itoa(i); g.ContainsString(k) != f.ContainsString(k) {
+			t.Fatalf("decoded ContainsString(%s) = %v, want %v", k, !f.ContainsString(k), f.ContainsString(k))
+		}
+	}
+
+	trailing := append([]byte(nil), data...)
+	trailing[len(trailing)-8] |= 0x80
+	bad := map[string][]byte{
+		"empty":         nil,
+		"short":         data[:bloomHeader-1],
+		"wrong kind":    append([]byte{countingBloomKind}, data[1:]...),
+		"truncated":     data[:len(data)-1],
+		"too long":      append(data[:len(data):len(data)], 0),
+		"bits past end": trailing,
+		"no hashes":     appendBloomHeader(nil, bloomKind, 64, 0),
+	}
+	for name, b := range bad {
+		if err := g.UnmarshalBinary(b); !errors.Is(err, ErrBloomData) {
+			t.Errorf("UnmarshalBinary() of %s data returned %v, want ErrBloomData", name, err)
+		}
+	}
+	if g.Bits() != f.Bits() || !g.ContainsString("1") {
+		t.Error("failed UnmarshalBinary() changed the filter")
+	}
+}
+
+func BenchmarkBloomFilterAdd(b *testing.B) {
+	f := NewBloomFilter(1<<20, 0.01)
+	key := []byte("some key of average length")
+	for b.Loop() {
+		f.Add(key)
+	}
+}
+
+func BenchmarkBloomFilterContains(b *testing.B) {
+	f := NewBloomFilter(1<<20, 0.01)
+	for i := range 1 << 20 {
+		f.AddString(strconv.Itoa(i))
+	}
+	key := []byte("some key of average length")
+	for b.Loop() {
+		f.Contains(key)
+	}
+}
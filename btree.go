@@ -0,0 +1,424 @@
+package ds
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+var ErrUnsorted = errors.New("input is not sorted")
+
+type btreeItem[K, V any] struct {
+	key   K
+	value V
+}
+
+// btreeCOW marks the nodes a tree may modify in place. Nodes with another
+// mark are shared with a clone and are copied before the first write.
+type btreeCOW struct {
+	_ byte
+}
+
+type btreeNode[K, V any] struct {
+	items    []btreeItem[K, V]
+	children []*btreeNode[K, V]
+	cow      *btreeCOW
+}
+
+func (n *btreeNode[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// BTree is an ordered map kept in a B-tree of minimum degree degree: every
+// node but the root holds from degree-1 to 2*degree-1 items.
+type BTree[K, V any] struct {
+	degree int
+	root   *btreeNode[K, V]
+	len    int
+	cmp    func(a, b K) int
+	cow    *btreeCOW
+}
+
+func NewBTree[K cmp.Ordered, V any](degree int) *BTree[K, V] {
+	return NewBTreeFunc[K, V](degree, cmp.Compare[K])
+}
+
+func NewBTreeFunc[K, V any](degree int, cmp func(a, b K) int) *BTree[K, V] {
+	return &BTree[K, V]{degree: max(degree, 2), cmp: cmp, cow: new(btreeCOW)}
+}
+
+func (t *BTree[K, V]) Len() int {
+	return t.len
+}
+
+func (t *BTree[K, V]) maxItems() int {
+	return 2*t.degree - 1
+}
+
+func (t *BTree[K, V]) minItems() int {
+	return t.degree - 1
+}
+
+// Clone returns a copy sharing all nodes with t. Both trees copy a shared
+// node the first time they modify it.
+func (t *BTree[K, V]) Clone() *BTree[K, V] {
+	c := *t
+	t.cow = new(btreeCOW)
+	c.cow = new(btreeCOW)
+	return &c
+}
+
+func (t *BTree[K, V]) newNode() *btreeNode[K, V] {
+	return &btreeNode[K, V]{cow: t.cow}
+}
+
+func (t *BTree[K, V]) mutable(n *btreeNode[K, V]) *btreeNode[K, V] {
+	if n.cow == t.cow {
+		return n
+	}
+	c := t.newNode()
+	c.items = append(make([]btreeItem[K, V], 0, t.maxItems()), n.items...)
+	if !n.leaf() {
+		c.children = append(make([]*btreeNode[K, V], 0, t.maxItems()+1), n.children...)
+	}
+	return c
+}
+
+func (t *BTree[K, V]) mutableChild(n *btreeNode[K, V], i int) *btreeNode[K, V] {
+	c := t.mutable(n.children[i])
+	n.children[i] = c
+	return c
+}
+
+// search returns the index of the first item not less than k and whether it
+// is equal to k.
+func (t *BTree[K, V]) search(n *btreeNode[K, V], k K) (int, bool) {
+	return slices.BinarySearchFunc(n.items, k, func(it btreeItem[K, V], k K) int {
+		return t.cmp(it.key, k)
+	})
+}
+
+func (t *BTree[K, V]) Get(k K) (V, bool) {
+	n := t.root
+	for n != nil {
+		i, found := t.search(n, k)
+		if found {
+			return n.items[i].value, true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n.items[0].key, n.items[0].value, true
+}
+
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	last := n.items[len(n.items)-1]
+	return last.key, last.value, true
+}
+
+func (t *BTree[K, V]) Put(k K, v V) {
+	if t.root == nil {
+		t.root = t.newNode()
+		t.root.items = append(t.root.items, btreeItem[K, V]{k, v})
+		t.len++
+		return
+	}
+	t.root = t.mutable(t.root)
+	if len(t.root.items) == t.maxItems() {
+		left := t.root
+		mid, right := t.split(left)
+		t.root = t.newNode()
+		t.root.items = append(t.root.items, mid)
+		t.root.children = append(t.root.children, left, right)
+	}
+	if t.insert(t.root, k, v) {
+		t.len++
+	}
+}
+
+// split moves the upper half of the full node n to a new node and returns
+// the middle item separating them.
+func (t *BTree[K, V]) split(n *btreeNode[K, V]) (btreeItem[K, V], *btreeNode[K, V]) {
+	mid := n.items[t.degree-1]
+	right := t.newNode()
+	right.items = append(right.items, n.items[t.degree:]...)
+	clear(n.items[t.degree-1:])
+	n.items = n.items[:t.degree-1]
+	if !n.leaf() {
+		right.children = append(right.children, n.children[t.degree:]...)
+		clear(n.children[t.degree:])
+		n.children = n.children[:t.degree]
+	}
+	return mid, right
+}
+
+// insert puts k into the subtree of the non-full node n and reports whether
+// a new key was added.
+func (t *BTree[K, V]) insert(n *btreeNode[K, V], k K, v V) bool {
+	i, found := t.search(n, k)
+	if found {
+		n.items[i].value = v
+		return false
+	}
+	if n.leaf() {
+		n.items = slices.Insert(n.items, i, btreeItem[K, V]{k, v})
+		return true
+	}
+	if len(n.children[i].items) == t.maxItems() {
+		mid, right := t.split(t.mutableChild(n, i))
+		n.items = slices.Insert(n.items, i, mid)
+		n.children = slices.Insert(n.children, i+1, right)
+		switch c := t.cmp(k, mid.key); {
+		case c == 0:
+			n.items[i].value = v
+			return false
+		case c > 0:
+			i++
+		}
+	}
+	return t.insert(t.mutableChild(n, i), k, v)
+}
+
+func (t *BTree[K, V]) Delete(k K) bool {
+	if t.root == nil {
+		return false
+	}
+	t.root = t.mutable(t.root)
+	deleted := t.delete(t.root, k)
+	if len(t.root.items) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	if deleted {
+		t.len--
+	}
+	return deleted
+}
+
+// delete removes k from the subtree of n, which is either the root or has
+// more than the minimum number of items.
+func (t *BTree[K, V]) delete(n *btreeNode[K, V], k K) bool {
+	i, found := t.search(n, k)
+	if n.leaf() {
+		if found {
+			n.items = slices.Delete(n.items, i, i+1)
+		}
+		return found
+	}
+
+	if found {
+		switch {
+		case len(n.children[i].items) > t.minItems():
+			n.items[i] = t.deleteMax(t.mutableChild(n, i))
+			return true
+		case len(n.children[i+1].items) > t.minItems():
+			n.items[i] = t.deleteMin(t.mutableChild(n, i+1))
+			return true
+		}
+		t.merge(n, i)
+		return t.delete(t.mutableChild(n, i), k)
+	}
+
+	i = t.grow(n, i)
+	return t.delete(t.mutableChild(n, i), k)
+}
+
+func (t *BTree[K, V]) deleteMin(n *btreeNode[K, V]) btreeItem[K, V] {
+	if n.leaf() {
+		it := n.items[0]
+		n.items = slices.Delete(n.items, 0, 1)
+		return it
+	}
+	t.grow(n, 0)
+	return t.deleteMin(t.mutableChild(n, 0))
+}
+
+func (t *BTree[K, V]) deleteMax(n *btreeNode[K, V]) btreeItem[K, V] {
+	if n.leaf() {
+		last := len(n.items) - 1
+		it := n.items[last]
+		n.items = slices.Delete(n.items, last, last+1)
+		return it
+	}
+	i := t.grow(n, len(n.children)-1)
+	return t.deleteMax(t.mutableChild(n, i))
+}
+
+// grow makes sure the i-th child of n has more than the minimum number of
+// items, borrowing from a sibling or merging with it, and returns the new
+// index of that child.
+func (t *BTree[K, V]) grow(n *btreeNode[K, V], i int) int {
+	if len(n.children[i].items) > t.minItems() {
+		return i
+	}
+
+	if i > 0 && len(n.children[i-1].items) > t.minItems() {
+		child, left := t.mutableChild(n, i), t.mutableChild(n, i-1)
+		child.items = slices.Insert(child.items, 0, n.items[i-1])
+		last := len(left.items) - 1
+		n.items[i-1] = left.items[last]
+		left.items = slices.Delete(left.items, last, last+1)
+		if !left.leaf() {
+			last := len(left.children) - 1
+			child.children = slices.Insert(child.children, 0, left.children[last])
+			left.children = slices.Delete(left.children, last, last+1)
+		}
+		return i
+	}
+
+	if i < len(n.items) && len(n.children[i+1].items) > t.minItems() {
+		child, right := t.mutableChild(n, i), t.mutableChild(n, i+1)
+		child.items = append(child.items, n.items[i])
+		n.items[i] = right.items[0]
+		right.items = slices.Delete(right.items, 0, 1)
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+		return i
+	}
+
+	if i == len(n.items) {
+		i--
+	}
+	t.merge(n, i)
+	return i
+}
+
+// merge joins the i-th child of n, the i-th item and the next child into the
+// i-th child.
+func (t *BTree[K, V]) merge(n *btreeNode[K, V], i int) {
+	left, right := t.mutableChild(n, i), n.children[i+1]
+	left.items = append(left.items, n.items[i])
+	left.items = append(left.items, right.items...)
+	left.children = append(left.children, right.children...)
+	n.items = slices.Delete(n.items, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// BulkLoad fills an empty tree from entries sorted by strictly increasing
+// keys in O(n), packing the nodes as tightly as possible.
+func (t *BTree[K, V]) BulkLoad(sorted iter.Seq2[K, V]) error {
+	if t.len != 0 {
+		return fmt.Errorf("bulk load into a tree with %d keys", t.len)
+	}
+	var items []btreeItem[K, V]
+	for k, v := range sorted {
+		if n := len(items); n > 0 && t.cmp(items[n-1].key, k) >= 0 {
+			return fmt.Errorf("%w: key %v after %v", ErrUnsorted, k, items[n-1].key)
+		}
+		items = append(items, btreeItem[K, V]{k, v})
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	t.len = len(items)
+
+	var children []*btreeNode[K, V]
+	for {
+		if len(items) <= t.maxItems() {
+			t.root = t.newNode()
+			t.root.items = items
+			t.root.children = children
+			break
+		}
+		// every node takes its items and the separator after it, so nodes
+		// get from degree to 2*degree of those slots
+		groups := (len(items) + 1 + 2*t.degree - 1) / (2 * t.degree)
+		var seps []btreeItem[K, V]
+		var parents []*btreeNode[K, V]
+		start := 0
+		for g := range groups {
+			slots := (len(items) + 1) / groups
+			if g < (len(items)+1)%groups {
+				slots++
+			}
+			n := t.newNode()
+			n.items = slices.Clone(items[start : start+slots-1])
+			if children != nil {
+				n.children = slices.Clone(children[start : start+slots])
+			}
+			parents = append(parents, n)
+			if g < groups-1 {
+				seps = append(seps, items[start+slots-1])
+			}
+			start += slots
+		}
+		items, children = seps, parents
+	}
+	return nil
+}
+
+func (t *BTree[K, V]) Clear() {
+	t.root = nil
+	t.len = 0
+}
+
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, nil, nil, yield)
+	}
+}
+
+// Range yields the entries with lo <= key < hi in ascending order.
+func (t *BTree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, &lo, &hi, yield)
+	}
+}
+
+func (t *BTree[K, V]) ascend(n *btreeNode[K, V], lo, hi *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	i := 0
+	if lo != nil {
+		i, _ = t.search(n, *lo)
+	}
+	for ; i < len(n.items); i++ {
+		if !n.leaf() && !t.ascend(n.children[i], lo, hi, yield) {
+			return false
+		}
+		it := n.items[i]
+		if hi != nil && t.cmp(it.key, *hi) >= 0 {
+			return false
+		}
+		if !yield(it.key, it.value) {
+			return false
+		}
+	}
+	if !n.leaf() {
+		return t.ascend(n.children[len(n.items)], lo, hi, yield)
+	}
+	return true
+}
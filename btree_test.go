@@ -0,0 +1,474 @@
+package ds
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func newTestBTree(degree int, keys ...int) *BTree[int, string] {
+	t := NewBTree[int, string](degree)
+	for _, k := range keys {
+		t.Put(k, strconv.Itoa(k))
+	}
+	return t
+}
+
+// checkBTree verifies key order, node sizes, leaf depth and the length of t.
+func checkBTree[K, V any](t *testing.T, tr *BTree[K, V]) {
+	t.Helper()
+	leafDepth := -1
+	count := 0
+	var walk func(n *btreeNode[K, V], depth int, lo, hi *K) error
+	walk = func(n *btreeNode[K, V], depth int, lo, hi *K) error {
+		if n != tr.root && len(n.items) < tr.minItems() || len(n.items) > tr.maxItems() {
+			return fmt.Errorf("node at depth %d has %d items", depth, len(n.items))
+		}
+		for i, it := range n.items {
+			if i > 0 && tr.cmp(n.items[i-1].key, it.key) >= 0 {
+				return fmt.Errorf("keys %v and %v are out of order", n.items[i-1].key, it.key)
+			}
+			if lo != nil && tr.cmp(it.key, *lo) <= 0 || hi != nil && tr.cmp(it.key, *hi) >= 0 {
+				return fmt.Errorf("key %v is outside of its subtree bounds", it.key)
+			}
+		}
+		count += len(n.items)
+		if n.leaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			}
+			if depth != leafDepth {
+				return fmt.Errorf("leaf at depth %d, want %d", depth, leafDepth)
+			}
+			return nil
+		}
+		if len(n.children) != len(n.items)+1 {
+			return fmt.Errorf("node with %d items has %d children", len(n.items), len(n.children))
+		}
+		for i, c := range n.children {
+			clo, chi := lo, hi
+			if i > 0 {
+				clo = &n.items[i-1].key
+			}
+			if i < len(n.items) {
+				chi = &n.items[i].key
+			}
+			if err := walk(c, depth+1, clo, chi); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if tr.root != nil {
+		if err := walk(tr.root, 0, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if count != tr.Len() {
+		t.Fatalf("tree holds %d keys, Len() = %d", count, tr.Len())
+	}
+}
+
+func btreeKeys[V any](seq iter.Seq2[int, V]) []int {
+	ks := []int{}
+	for k := range seq {
+		ks = append(ks, k)
+	}
+	return ks
+}
+
+func TestBTreePutGetDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		degree   int
+		init     []int
+		delete   []int
+		wantKeys []int
+		wantDel  []bool
+	}{
+		{
+			name:     "empty",
+			degree:   2,
+			wantKeys: []int{},
+		},
+		{
+			name:     "ascending inserts split the root",
+			degree:   2,
+			init:     []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			wantKeys: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+		{
+			name:     "duplicate keys",
+			degree:   2,
+			init:     []int{3, 1, 3, 2, 1},
+			wantKeys: []int{1, 2, 3},
+		},
+		{
+			name:     "delete from leaves, inner nodes and missing",
+			degree:   2,
+			init:     []int{10, 20, 30, 40, 50, 60, 70, 80, 90},
+			delete:   []int{40, 10, 35, 80},
+			wantKeys: []int{20, 30, 50, 60, 70, 90},
+			wantDel:  []bool{true, true, false, true},
+		},
+		{
+			name:     "delete everything",
+			degree:   3,
+			init:     []int{5, 2, 8, 1, 9, 3, 7, 4, 6},
+			delete:   []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 5},
+			wantKeys: []int{},
+			wantDel:  []bool{true, true, true, true, true, true, true, true, true, false},
+		},
+		{
+			name:     "degree below two is raised",
+			degree:   0,
+			init:     []int{3, 2, 1, 4},
+			wantKeys: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newTestBTree(tt.degree, tt.init...)
+			var gotDel []bool
+			for _, k := range tt.delete {
+				gotDel = append(gotDel, tr.Delete(k))
+			}
+
+			if !slices.Equal(gotDel, tt.wantDel) {
+				t.Errorf("Delete() results = %v, want %v", gotDel, tt.wantDel)
+			}
+			if got := btreeKeys(tr.All()); !slices.Equal(got, tt.wantKeys) {
+				t.Errorf("All() keys = %v, want %v", got, tt.wantKeys)
+			}
+			for _, k := range tt.wantKeys {
+				if v, ok := tr.Get(k); !ok || v != strconv.Itoa(k) {
+					t.Errorf("Get(%d) = (%q, %v), want (%q, true)", k, v, ok, strconv.Itoa(k))
+				}
+			}
+			for _, k := range tt.delete {
+				if _, ok := tr.Get(k); ok {
+					t.Errorf("Get(%d) found a deleted key", k)
+				}
+			}
+			checkBTree(t, tr)
+		})
+	}
+
+	t.Run("overwrite value", func(t *testing.T) {
+		tr := NewBTree[string, int](2)
+		for i, k := range []string{"a", "b", "c", "d", "a", "c"} {
+			tr.Put(k, i)
+		}
+		if got, ok := tr.Get("a"); got != 4 || !ok {
+			t.Errorf("Get(a) = (%v, %v), want (4, true)", got, ok)
+		}
+		if got, ok := tr.Get("c"); got != 5 || !ok {
+			t.Errorf("Get(c) = (%v, %v), want (5, true)", got, ok)
+		}
+		if got := tr.Len(); got != 4 {
+			t.Errorf("Len() = %d, want 4", got)
+		}
+	})
+
+	t.Run("min and max", func(t *testing.T) {
+		tr := newTestBTree(2, 50, 20, 80, 10, 30, 70, 90)
+		if k, _, ok := tr.Min(); k != 10 || !ok {
+			t.Errorf("Min() = (%v, %v), want (10, true)", k, ok)
+		}
+		if k, _, ok := tr.Max(); k != 90 || !ok {
+			t.Errorf("Max() = (%v, %v), want (90, true)", k, ok)
+		}
+		tr.Clear()
+		if _, _, ok := tr.Min(); ok {
+			t.Error("Min() of cleared tree ok")
+		}
+		if _, _, ok := tr.Max(); ok {
+			t.Error("Max() of cleared tree ok")
+		}
+	})
+}
+
+func TestBTreeRange(t *testing.T) {
+	tr := newTestBTree(2)
+	for k := 0; k < 100; k += 5 {
+		tr.Put(k, strconv.Itoa(k))
+	}
+
+	tests := []struct {
+		name   string
+		lo, hi int
+		want   []int
+	}{
+		{"inner", 12, 31, []int{15, 20, 25, 30}},
+		{"excludes hi", 15, 30, []int{15, 20, 25}},
+		{"below minimum", -10, 6, []int{0, 5}},
+		{"above maximum", 90, 200, []int{90, 95}},
+		{"outside", 100, 200, []int{}},
+		{"empty", 50, 50, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := btreeKeys(tr.Range(tt.lo, tt.hi)); !slices.Equal(got, tt.want) {
+				t.Errorf("Range(%d, %d) keys = %v, want %v", tt.lo, tt.hi, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("early stop", func(t *testing.T) {
+		var got []int
+		for k := range tr.Range(20, 80) {
+			got = append(got, k)
+			if k == 35 {
+				break
+			}
+		}
+		if want := []int{20, 25, 30, 35}; !slices.Equal(got, want) {
+			t.Errorf("keys before break = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestBTreeBulkLoad(t *testing.T) {
+	entries := func(keys []int) iter.Seq2[int, string] {
+		return func(yield func(int, string) bool) {
+			for _, k := range keys {
+				if !yield(k, strconv.Itoa(k)) {
+					return
+				}
+			}
+		}
+	}
+
+	for _, degree := range []int{2, 3, 16} {
+		for _, n := range []int{0, 1, 2, 3, 7, 8, 100, 1000} {
+			t.Run(fmt.Sprintf("degree %d, %d keys", degree, n), func(t *testing.T) {
+				keys := make([]int, n)
+				for i := range keys {
+					keys[i] = 2 * i
+				}
+				tr := NewBTree[int, string](degree)
+				if err := tr.BulkLoad(entries(keys)); err != nil {
+					t.Fatalf("BulkLoad() error = %v", err)
+				}
+				checkBTree(t, tr)
+				if got := btreeKeys(tr.All()); !slices.Equal(got, keys) {
+					t.Fatalf("All() keys = %v, want %v", got, keys)
+				}
+
+				// the packed tree must keep working under updates
+				for i := range n {
+					tr.Put(2*i+1, "")
+					if i%2 == 0 {
+						tr.Delete(2 * i)
+					}
+				}
+				checkBTree(t, tr)
+			})
+		}
+	}
+
+	t.Run("unsorted input", func(t *testing.T) {
+		tr := NewBTree[int, string](2)
+		err := tr.BulkLoad(entries([]int{1, 3, 3, 4}))
+		if !errors.Is(err, ErrUnsorted) {
+			t.Errorf("BulkLoad() error = %v, want %v", err, ErrUnsorted)
+		}
+		if tr.Len() != 0 {
+			t.Errorf("Len() after failed BulkLoad() = %d, want 0", tr.Len())
+		}
+	})
+
+	t.Run("non-empty tree", func(t *testing.T) {
+		tr := newTestBTree(2, 1)
+		if err := tr.BulkLoad(entries([]int{2})); err == nil {
+			t.Error("BulkLoad() into a non-empty tree succeeded")
+		}
+	})
+}
+
+func TestBTreeClone(t *testing.T) {
+	orig := NewBTree[int, int](2)
+	for k := range 200 {
+		orig.Put(k, k)
+	}
+	clone := orig.Clone()
+	if clone.root != orig.root {
+		t.Fatal("Clone() copied the root, want it shared")
+	}
+
+	for k := range 100 {
+		clone.Delete(2 * k)
+		clone.Put(1000+k, k)
+		orig.Put(k, -k)
+	}
+	checkBTree(t, orig)
+	checkBTree(t, clone)
+
+	for k := range 200 {
+		want := k
+		if k < 100 {
+			want = -k
+		}
+		if got, ok := orig.Get(k); got != want || !ok {
+			t.Fatalf("orig.Get(%d) = (%v, %v), want (%v, true)", k, got, ok, want)
+		}
+		got, ok := clone.Get(k)
+		if wantOk := k%2 == 1; ok != wantOk || ok && got != k {
+			t.Fatalf("clone.Get(%d) = (%v, %v), want (%v, %v)", k, got, ok, k, wantOk)
+		}
+	}
+	if _, ok := orig.Get(1000); ok {
+		t.Fatal("key put into the clone is visible in the original")
+	}
+
+	t.Run("untouched subtrees stay shared", func(t *testing.T) {
+		a := NewBTree[int, int](2)
+		for k := range 1000 {
+			a.Put(k, k)
+		}
+		b := a.Clone()
+		b.Put(0, -1)
+		last := len(a.root.children) - 1
+		if a.root.children[last] != b.root.children[last] {
+			t.Error("Put() into the first subtree copied the last one")
+		}
+		if a.root.children[0] == b.root.children[0] {
+			t.Error("Put() into the first subtree did not copy it")
+		}
+	})
+}
+
+func TestBTreeModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	trees := []*BTree[int, int]{NewBTree[int, int](2), NewBTree[int, int](3)}
+	models := []map[int]int{{}, {}}
+
+	for step := range 8000 {
+		k := r.IntN(600)
+		for i, tr := range trees {
+			model := models[i]
+			if r.IntN(3) == 0 {
+				_, had := model[k]
+				if got := tr.Delete(k); got != had {
+					t.Fatalf("step %d: Delete(%d) = %v, want %v", step, k, got, had)
+				}
+				delete(model, k)
+			} else {
+				tr.Put(k, step)
+				model[k] = step
+			}
+		}
+
+		// fork occasionally so the model also covers shared nodes
+		if step%1000 == 999 {
+			trees[1] = trees[0].Clone()
+			models[1] = maps.Clone(models[0])
+		}
+
+		if step%200 == 0 {
+			for i, tr := range trees {
+				checkBTree(t, tr)
+				want := slices.Sorted(maps.Keys(models[i]))
+				var got []int
+				for k, v := range tr.All() {
+					if v != models[i][k] {
+						t.Fatalf("step %d: value of %d = %d, want %d", step, k, v, models[i][k])
+					}
+					got = append(got, k)
+				}
+				if !slices.Equal(got, want) {
+					t.Fatalf("step %d: keys = %v, want %v", step, got, want)
+				}
+			}
+		}
+	}
+}
+
+const btreeBenchSize = 100000
+
+func BenchmarkBTreeGet(b *testing.B) {
+	tr := NewBTree[int, int](32)
+	for i := range btreeBenchSize {
+		tr.Put(i, i)
+	}
+	for i := 0; b.Loop(); i++ {
+		tr.Get(i * 7919 % btreeBenchSize)
+	}
+}
+
+func BenchmarkTreeMapGet(b *testing.B) {
+	m := NewTreeMap[int, int]()
+	for i := range btreeBenchSize {
+		m.Put(i, i)
+	}
+	for i := 0; b.Loop(); i++ {
+		m.Get(i * 7919 % btreeBenchSize)
+	}
+}
+
+func BenchmarkSortedSliceGet(b *testing.B) {
+	s := make([]int, btreeBenchSize)
+	for i := range s {
+		s[i] = i
+	}
+	for i := 0; b.Loop(); i++ {
+		sort.SearchInts(s, i*7919%btreeBenchSize)
+	}
+}
+
+func BenchmarkBTreePutDelete(b *testing.B) {
+	tr := NewBTree[int, int](32)
+	for i := range btreeBenchSize {
+		tr.Put(2*i, i)
+	}
+	for i := 0; b.Loop(); i++ {
+		k := 2*(i*7919%btreeBenchSize) + 1
+		tr.Put(k, i)
+		tr.Delete(k)
+	}
+}
+
+func BenchmarkTreeMapPutDelete(b *testing.B) {
+	m := NewTreeMap[int, int]()
+	for i := range btreeBenchSize {
+		m.Put(2*i, i)
+	}
+	for i := 0; b.Loop(); i++ {
+		k := 2*(i*7919%btreeBenchSize) + 1
+		m.Put(k, i)
+		m.Delete(k)
+	}
+}
+
+func BenchmarkSortedSlicePutDelete(b *testing.B) {
+	s := make([]int, btreeBenchSize)
+	for i := range s {
+		s[i] = 2 * i
+	}
+	for i := 0; b.Loop(); i++ {
+		k := 2*(i*7919%btreeBenchSize) + 1
+		j := sort.SearchInts(s, k)
+		s = slices.Insert(s, j, k)
+		s = slices.Delete(s, j, j+1)
+	}
+}
+
+func BenchmarkBTreeClone(b *testing.B) {
+	tr := NewBTree[int, int](32)
+	for i := range btreeBenchSize {
+		tr.Put(i, i)
+	}
+	b.ReportAllocs()
+	for i := 0; b.Loop(); i++ {
+		c := tr.Clone()
+		c.Put(i%btreeBenchSize, -i)
+	}
+}
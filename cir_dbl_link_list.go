@@ -0,0 +1,359 @@
+package ds
+
+import (
+	"fmt"
+	"iter"
+)
+
+// CDLNode is a handle to an element of a CDLList. Next and Prev wrap around
+// the ring.
+type CDLNode[E comparable] struct {
+	Value E
+	next  *CDLNode[E]
+	prev  *CDLNode[E]
+	list  *CDLList[E]
+}
+
+func (n *CDLNode[E]) Next() *CDLNode[E] {
+	return n.next
+}
+
+func (n *CDLNode[E]) Prev() *CDLNode[E] {
+	return n.prev
+}
+
+type CDLList[E comparable] struct {
+	tail *CDLNode[E]
+	len  int
+}
+
+var _ List[int] = (*CDLList[int])(nil)
+
+func NewCDLList[E comparable](vs ...E) *CDLList[E] {
+	l := &CDLList[E]{}
+	for _, v := range vs {
+		l.Append(v)
+	}
+	return l
+}
+
+func (l *CDLList[E]) String() string {
+	return formatList("CDLList", l.len, l.Iter())
+}
+
+func (l *CDLList[E]) Length() int {
+	return l.len
+}
+
+func (l *CDLList[E]) Front() *CDLNode[E] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.tail.next
+}
+
+func (l *CDLList[E]) Back() *CDLNode[E] {
+	return l.tail
+}
+
+func (l *CDLList[E]) Clone() *CDLList[E] {
+	c := &CDLList[E]{}
+	for _, v := range l.Iter() {
+		c.Append(v)
+	}
+	return c
+}
+
+// nodeAt walks from the nearer end of the ring.
+func (l *CDLList[E]) nodeAt(i int) *CDLNode[E] {
+	if i < l.len/2 {
+		curr := l.tail.next
+		for range i {
+			curr = curr.next
+		}
+		return curr
+	}
+	curr := l.tail
+	for range l.len - 1 - i {
+		curr = curr.prev
+	}
+	return curr
+}
+
+func (l *CDLList[E]) Get(i int) (E, error) {
+	var zero E
+	if i < 0 {
+		return zero, fmt.Errorf("%w [%d]", ErrIndexOutOfRange, i)
+	}
+	if i >= l.len {
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	return l.nodeAt(i).Value, nil
+}
+
+func (l *CDLList[E]) MustGet(i int) E {
+	v, err := l.Get(i)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (l *CDLList[E]) GetRel(i int) (E, error) {
+	if l.len == 0 {
+		var zero E
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	return l.Get(wrapIndex(i, l.len))
+}
+
+func (l *CDLList[E]) At(i int) (E, bool) {
+	if i < 0 || i >= l.len {
+		var zero E
+		return zero, false
+	}
+	return l.nodeAt(i).Value, true
+}
+
+func (l *CDLList[E]) FindFirst(v E) int {
+	for i, val := range l.Iter() {
+		if val == v {
+			return i
+		}
+	}
+	return -1
+}
+
+func (l *CDLList[E]) FindLast(v E) int {
+	for i, val := range l.Backward() {
+		if val == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// insertAfter links a new node after at, or creates the ring if the list is
+// empty.
+func (l *CDLList[E]) insertAfter(v E, at *CDLNode[E]) *CDLNode[E] {
+	n := &CDLNode[E]{Value: v, list: l}
+	if l.len == 0 {
+		n.next, n.prev = n, n
+		l.tail = n
+	} else {
+		l.link(n, at)
+	}
+	l.len++
+	return n
+}
+
+func (l *CDLList[E]) PushBack(v E) *CDLNode[E] {
+	l.tail = l.insertAfter(v, l.tail)
+	return l.tail
+}
+
+func (l *CDLList[E]) PushFront(v E) *CDLNode[E] {
+	return l.insertAfter(v, l.tail)
+}
+
+func (l *CDLList[E]) Append(v E) {
+	l.PushBack(v)
+}
+
+func (l *CDLList[E]) Insert(v E, i int) error {
+	if i < 0 {
+		return fmt.Errorf("%w [%d:]", ErrListBounds, i)
+	}
+	if i > l.len {
+		return fmt.Errorf("%w [%d:%d]", ErrListBounds, i, l.len)
+	}
+
+	switch i {
+	case 0:
+		l.PushFront(v)
+	case l.len:
+		l.PushBack(v)
+	default:
+		l.insertAfter(v, l.nodeAt(i-1))
+	}
+	return nil
+}
+
+func (l *CDLList[E]) InsertRel(v E, i int) error {
+	return l.Insert(v, insertIndex(i, l.len))
+}
+
+func (l *CDLList[E]) MustInsert(v E, i int) {
+	if err := l.Insert(v, i); err != nil {
+		panic(err)
+	}
+}
+
+func (l *CDLList[E]) Extend(es *CDLList[E]) {
+	if es == nil {
+		return
+	}
+	for _, v := range es.Iter() {
+		l.Append(v)
+	}
+}
+
+func (l *CDLList[E]) Reverse() {
+	if l.len == 0 {
+		return
+	}
+	head := l.tail.next
+	curr := head
+	for range l.len {
+		curr.next, curr.prev = curr.prev, curr.next
+		curr = curr.prev
+	}
+	l.tail = head
+}
+
+// Remove unlinks n from the list in O(1) and clears its links, so Next and
+// Prev of a removed node return nil. Nodes of other lists are ignored.
+func (l *CDLList[E]) Remove(n *CDLNode[E]) E {
+	if n.list != l {
+		return n.Value
+	}
+	l.unlink(n)
+	if n == l.tail {
+		l.tail = n.prev
+	}
+	n.list, n.next, n.prev = nil, nil, nil
+	l.len--
+	if l.len == 0 {
+		l.tail = nil
+	}
+	return n.Value
+}
+
+// MoveToFront relinks n as the first node in O(1). Nodes of other lists are
+// ignored.
+func (l *CDLList[E]) MoveToFront(n *CDLNode[E]) {
+	if n.list != l || n == l.tail.next {
+		return
+	}
+	if n == l.tail {
+		l.tail = n.prev
+		return
+	}
+	l.unlink(n)
+	l.link(n, l.tail)
+}
+
+// MoveToBack relinks n as the last node in O(1). Nodes of other lists are
+// ignored.
+func (l *CDLList[E]) MoveToBack(n *CDLNode[E]) {
+	if n.list != l || n == l.tail {
+		return
+	}
+	if n == l.tail.next {
+		l.tail = n
+		return
+	}
+	l.unlink(n)
+	l.link(n, l.tail)
+	l.tail = n
+}
+
+func (l *CDLList[E]) unlink(n *CDLNode[E]) {
+	n.prev.next = n.next
+	n.next.prev = n.prev
+}
+
+func (l *CDLList[E]) link(n, at *CDLNode[E]) {
+	n.prev, n.next = at, at.next
+	at.next.prev = n
+	at.next = n
+}
+
+func (l *CDLList[E]) Delete(i int) (E, error) {
+	var zero E
+	if i < 0 {
+		return zero, fmt.Errorf("%w [%d]", ErrIndexOutOfRange, i)
+	}
+	if i >= l.len {
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	return l.Remove(l.nodeAt(i)), nil
+}
+
+func (l *CDLList[E]) DeleteRel(i int) (E, error) {
+	if l.len == 0 {
+		var zero E
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	return l.Delete(wrapIndex(i, l.len))
+}
+
+func (l *CDLList[E]) MustDelete(i int) E {
+	v, err := l.Delete(i)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (l *CDLList[E]) PopBack() (E, bool) {
+	if l.len == 0 {
+		var zero E
+		return zero, false
+	}
+	return l.Remove(l.tail), true
+}
+
+func (l *CDLList[E]) DeleteAll(v E) {
+	if l.len == 0 {
+		return
+	}
+	curr := l.tail.next
+	for range l.len {
+		next := curr.next
+		if curr.Value == v {
+			l.Remove(curr)
+		}
+		curr = next
+	}
+}
+
+func (l *CDLList[E]) Clear() {
+	if l.len > 0 {
+		curr := l.tail
+		for range l.len {
+			next := curr.next
+			curr.list, curr.next, curr.prev = nil, nil, nil
+			curr = next
+		}
+	}
+	l.tail = nil
+	l.len = 0
+}
+
+func (l *CDLList[E]) Iter() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		curr := l.tail
+		for i := range l.len {
+			curr = curr.next
+			if !yield(i, curr.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (l *CDLList[E]) Backward() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		if l.len == 0 {
+			return
+		}
+		curr := l.tail
+		for i := l.len - 1; i >= 0; i-- {
+			if !yield(i, curr.Value) {
+				return
+			}
+			curr = curr.prev
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package ds
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCDLListLength(t *testing.T) {
+	testListLength(t, NewCDLList[int])
+}
+
+func TestCDLListAppend(t *testing.T) {
+	testListAppend(t, NewCDLList[int])
+}
+
+func TestCDLListGet(t *testing.T) {
+	testListGet(t, NewCDLList[int])
+}
+
+func TestCDLListInsert(t *testing.T) {
+	testListInsert(t, NewCDLList[int])
+}
+
+func TestCDLListDelete(t *testing.T) {
+	testListDelete(t, NewCDLList[int])
+}
+
+func TestCDLListDeleteAll(t *testing.T) {
+	testListDeleteAll(t, NewCDLList[int])
+}
+
+func TestCDLListClone(t *testing.T) {
+	testListClone(t, NewCDLList[int])
+}
+
+func TestCDLListExtend(t *testing.T) {
+	testListExtend(t, NewCDLList[int])
+}
+
+func TestCDLListFindFirstLast(t *testing.T) {
+	testListFindFirstLast(t, NewCDLList[int])
+}
+
+func TestCDLListReverse(t *testing.T) {
+	testListReverse(t, NewCDLList[int])
+}
+
+func TestCDLListClear(t *testing.T) {
+	testListClear(t, NewCDLList[int])
+}
+
+func TestCDLListIter(t *testing.T) {
+	testListIter(t, NewCDLList[int])
+}
+
+func TestCDLListAt(t *testing.T) {
+	testListAt(t, NewCDLList[int])
+}
+
+func TestCDLListMust(t *testing.T) {
+	testListMust(t, NewCDLList[int])
+}
+
+func TestCDLListRel(t *testing.T) {
+	testListRel(t, NewCDLList[int])
+}
+
+func TestCDLListDeleteTail(t *testing.T) {
+	testListDeleteTail(t, NewCDLList[int])
+}
+
+func TestCDLListString(t *testing.T) {
+	tests := []struct {
+		name string
+		data []rune
+		want string
+	}{
+		{
+			name: "empty",
+			data: []rune{},
+			want: "CDLList{  }",
+		},
+		{
+			name: "runes",
+			data: []rune{'a', 'b', 'c'},
+			want: "CDLList{ a b c }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewCDLList(tt.data...).String(); got != tt.want {
+				t.Errorf("NewCDLList(%v).String() = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCDLListPopBack(t *testing.T) {
+	tests := []struct {
+		name     string
+		init     []int
+		pops     int
+		wantVals []int
+		wantOks  []bool
+		want     string
+	}{
+		{
+			name:     "empty list",
+			init:     []int{},
+			pops:     1,
+			wantVals: []int{0},
+			wantOks:  []bool{false},
+			want:     "CDLList{  }",
+		},
+		{
+			name:     "pop some",
+			init:     []int{1, 2, 3},
+			pops:     2,
+			wantVals: []int{3, 2},
+			wantOks:  []bool{true, true},
+			want:     "CDLList{ 1 }",
+		},
+		{
+			name:     "pop past empty",
+			init:     []int{1},
+			pops:     2,
+			wantVals: []int{1, 0},
+			wantOks:  []bool{true, false},
+			want:     "CDLList{  }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewCDLList(tt.init...)
+			var gotVals []int
+			var gotOks []bool
+			for range tt.pops {
+				v, ok := l.PopBack()
+				gotVals = append(gotVals, v)
+				gotOks = append(gotOks, ok)
+			}
+
+			if !slices.Equal(gotVals, tt.wantVals) || !slices.Equal(gotOks, tt.wantOks) {
+				t.Errorf("PopBack() returned %v %v, want %v %v", gotVals, gotOks, tt.wantVals, tt.wantOks)
+			}
+			if got := l.String(); got != tt.want {
+				t.Errorf("list after PopBack() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCDLListBackward(t *testing.T) {
+	type pair struct {
+		index int
+		value int
+	}
+
+	tests := []struct {
+		name string
+		init []int
+		want []pair
+	}{
+		{
+			name: "empty list",
+			init: []int{},
+			want: nil,
+		},
+		{
+			name: "multiple elements",
+			init: []int{1, 2, 3},
+			want: []pair{{2, 3}, {1, 2}, {0, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewCDLList(tt.init...)
+
+			var got []pair
+			for idx, val := range l.Backward() {
+				got = append(got, pair{idx, val})
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Backward() produced %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCDLListRemove(t *testing.T) {
+	l := NewCDLList[int]()
+	n1 := l.PushBack(1)
+	n2 := l.PushBack(2)
+	n0 := l.PushFront(0)
+
+	if got := l.String(); got != "CDLList{ 0 1 2 }" {
+		t.Fatalf("list after pushes = %v, want CDLList{ 0 1 2 }", got)
+	}
+	if l.Front() != n0 || l.Back() != n2 || n0.Next() != n1 || n0.Prev() != n2 {
+		t.Fatalf("node handles are not linked as a ring")
+	}
+
+	if got := l.Remove(n1); got != 1 {
+		t.Errorf("Remove(n1) = %v, want 1", got)
+	}
+	if got := l.String(); got != "CDLList{ 0 2 }" {
+		t.Errorf("list after Remove(n1) = %v, want CDLList{ 0 2 }", got)
+	}
+	if n1.Next() != nil || n1.Prev() != nil {
+		t.Errorf("removed node links to %v and %v, want nil", n1.Next(), n1.Prev())
+	}
+
+	l.Remove(n1)
+	NewCDLList(5).Remove(n2)
+	if got := l.Length(); got != 2 {
+		t.Errorf("removing a detached or foreign node changed Length() to %d, want 2", got)
+	}
+
+	l.Remove(n2)
+	l.Append(3)
+	if got := l.String(); got != "CDLList{ 0 3 }" {
+		t.Errorf("list after Remove(back) and Append() = %v, want CDLList{ 0 3 }", got)
+	}
+
+	l.Remove(n0)
+	l.Remove(l.Back())
+	if l.Front() != nil || l.Back() != nil || l.Length() != 0 {
+		t.Errorf("list is not empty after removing every node: %v", l)
+	}
+
+	n4 := l.PushBack(4)
+	l.PushBack(5)
+	l.Clear()
+	if n4.Next() != nil || n4.Prev() != nil {
+		t.Error("node of a cleared list still has links")
+	}
+}
+
+func TestCDLListMove(t *testing.T) {
+	l := NewCDLList[int]()
+	n0, n1, n2 := l.PushBack(0), l.PushBack(1), l.PushBack(2)
+
+	tests := []struct {
+		name string
+		op   func()
+		want string
+	}{
+		{"back to front", func() { l.MoveToFront(n2) }, "CDLList{ 2 0 1 }"},
+		{"middle to front", func() { l.MoveToFront(n0) }, "CDLList{ 0 2 1 }"},
+		{"front to front", func() { l.MoveToFront(n0) }, "CDLList{ 0 2 1 }"},
+		{"front to back", func() { l.MoveToBack(n0) }, "CDLList{ 2 1 0 }"},
+		{"middle to back", func() { l.MoveToBack(n1) }, "CDLList{ 2 0 1 }"},
+		{"back to back", func() { l.MoveToBack(n1) }, "CDLList{ 2 0 1 }"},
+		{"foreign node", func() { NewCDLList(5).MoveToFront(n1) }, "CDLList{ 2 0 1 }"},
+	}
+
+	for _, tt := range tests {
+		tt.op()
+		if got := l.String(); got != tt.want {
+			t.Fatalf("%s: list = %v, want %v", tt.name, got, tt.want)
+		}
+		if l.Back() != l.Front().Prev() || l.Back().Next() != l.Front() {
+			t.Fatalf("%s: front and back are not linked as a ring", tt.name)
+		}
+	}
+
+	var back []int
+	for _, v := range l.Backward() {
+		back = append(back, v)
+	}
+	if want := []int{1, 0, 2}; !slices.Equal(back, want) {
+		t.Errorf("Backward() = %v, want %v", back, want)
+	}
+}
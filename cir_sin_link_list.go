@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"iter"
-	"strings"
 )
 
 var (
@@ -18,8 +17,9 @@ type node[E comparable] struct {
 }
 
 type cslList[E comparable] struct {
-	tail *node[E]
-	len  int
+	tail  *node[E]
+	len   int
+	alloc nodeAllocator[E]
 }
 
 func NewCSLList[E comparable](vs ...E) *cslList[E] {
@@ -34,27 +34,11 @@ func NewCSLList[E comparable](vs ...E) *cslList[E] {
 		tail = curr
 		tail.next = head
 	}
-	return &cslList[E]{tail, len(vs)}
+	return &cslList[E]{tail: tail, len: len(vs)}
 }
 
 func (l *cslList[E]) String() string {
-	if l.len == 0 {
-		return "cslList{  }"
-	}
-
-	var b strings.Builder
-	b.Grow(10 + l.Length()*2 - 1)
-	b.WriteString("cslList{ ")
-	for _, v := range l.Iter() {
-		switch v := any(v).(type) {
-		case rune:
-			b.WriteString(fmt.Sprintf("%c ", v))
-		default:
-			b.WriteString(fmt.Sprintf("%v ", v))
-		}
-	}
-	b.WriteString("}")
-	return b.String()
+	return formatList("cslList", l.len, l.Iter())
 }
 
 func (l *cslList[E]) Length() int {
@@ -62,19 +46,31 @@ func (l *cslList[E]) Length() int {
 }
 
 func (l *cslList[E]) Clone() *cslList[E] {
-	if l.len == 0 {
-		return &cslList[E]{}
+	c := &cslList[E]{}
+	if l.alloc != nil {
+		c.alloc = l.alloc.fork()
 	}
-	tail := &node[E]{data: l.tail.data}
+	c.tail = c.copyNodes(l)
+	c.len = l.len
+	return c
+}
+
+// copyNodes copies the nodes of src using l's allocator and returns the tail
+// of the copied ring.
+func (l *cslList[E]) copyNodes(src *cslList[E]) *node[E] {
+	if src.len == 0 {
+		return nil
+	}
+	tail := l.newNode(src.tail.data, nil)
 	currCopy := tail
-	curr := l.tail
-	for range l.len - 1 {
+	curr := src.tail
+	for range src.len - 1 {
 		curr = curr.next
-		currCopy.next = &node[E]{data: curr.data}
+		currCopy.next = l.newNode(curr.data, nil)
 		currCopy = currCopy.next
 	}
 	currCopy.next = tail
-	return &cslList[E]{tail, l.len}
+	return tail
 }
 
 func (l *cslList[E]) Get(i int) (E, error) {
@@ -93,6 +89,34 @@ func (l *cslList[E]) Get(i int) (E, error) {
 	return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
 }
 
+func (l *cslList[E]) MustGet(i int) E {
+	v, err := l.Get(i)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (l *cslList[E]) GetRel(i int) (E, error) {
+	if l.len == 0 {
+		var zero E
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	return l.Get(wrapIndex(i, l.len))
+}
+
+func (l *cslList[E]) At(i int) (E, bool) {
+	if i < 0 || i >= l.len {
+		var zero E
+		return zero, false
+	}
+	curr := l.tail.next
+	for range i {
+		curr = curr.next
+	}
+	return curr.data, true
+}
+
 func (l *cslList[E]) FindFirst(v E) int {
 	for i, val := range l.Iter() {
 		if val == v {
@@ -113,7 +137,7 @@ func (l *cslList[E]) FindLast(v E) int {
 }
 
 func (l *cslList[E]) Append(v E) {
-	node := &node[E]{data: v}
+	node := l.newNode(v, nil)
 	if l.len == 0 {
 		node.next = node
 		l.tail = node
@@ -132,12 +156,16 @@ func (l *cslList[E]) Insert(v E, i int) error {
 	if i > l.len {
 		return fmt.Errorf("%w [%d:%d]", ErrListBounds, i, l.len)
 	}
+	if l.len == 0 {
+		l.Append(v)
+		return nil
+	}
 
 	prev := l.tail
 	for range i {
 		prev = prev.next
 	}
-	node := &node[E]{v, prev.next}
+	node := l.newNode(v, prev.next)
 	prev.next = node
 	if i == l.len {
 		l.tail = node
@@ -146,17 +174,27 @@ func (l *cslList[E]) Insert(v E, i int) error {
 	return nil
 }
 
+func (l *cslList[E]) InsertRel(v E, i int) error {
+	return l.Insert(v, insertIndex(i, l.len))
+}
+
+func (l *cslList[E]) MustInsert(v E, i int) {
+	if err := l.Insert(v, i); err != nil {
+		panic(err)
+	}
+}
+
 func (l *cslList[E]) Extend(es *cslList[E]) {
 	if es == nil || es.len == 0 {
 		return
 	}
+	tail := l.copyNodes(es)
 	if l.len == 0 {
-		*l = *es.Clone()
+		l.tail, l.len = tail, es.len
 		return
 	}
 
-	copy := es.Clone()
-	l.tail.next, copy.tail.next, l.tail = copy.tail.next, l.tail.next, copy.tail
+	l.tail.next, tail.next, l.tail = tail.next, l.tail.next, tail
 	l.len += es.len
 }
 
@@ -188,25 +226,89 @@ func (l *cslList[E]) Delete(i int) (E, error) {
 	for range i {
 		prev = prev.next
 	}
-	v := prev.next.data
-	prev.next = prev.next.next
+	curr := prev.next
+	prev.next = curr.next
+	if curr == l.tail {
+		l.tail = prev
+	}
 	l.len--
+	if l.len == 0 {
+		l.tail = nil
+	}
+	v := curr.data
+	l.freeNode(curr)
 	return v, nil
 }
 
+func (l *cslList[E]) DeleteRel(i int) (E, error) {
+	if l.len == 0 {
+		var zero E
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	return l.Delete(wrapIndex(i, l.len))
+}
+
+func (l *cslList[E]) MustDelete(i int) E {
+	v, err := l.Delete(i)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (l *cslList[E]) DeleteAll(v E) {
 	prev := l.tail
 	for range l.len {
 		curr := prev.next
-		if curr.data == v {
-			prev.next = curr.next
-			l.len--
+		if curr.data != v {
+			prev = curr
+			continue
 		}
-		prev = prev.next
+		prev.next = curr.next
+		if curr == l.tail {
+			l.tail = prev
+		}
+		l.freeNode(curr)
+		l.len--
+	}
+	if l.len == 0 {
+		l.tail = nil
+	}
+}
+
+// Distinct deletes every element equal to an earlier one and returns how
+// many were deleted.
+func (l *cslList[E]) Distinct() int {
+	seen := make(map[E]struct{}, l.len)
+	deleted := 0
+	prev := l.tail
+	for range l.len {
+		curr := prev.next
+		if _, ok := seen[curr.data]; !ok {
+			seen[curr.data] = struct{}{}
+			prev = curr
+			continue
+		}
+		prev.next = curr.next
+		if curr == l.tail {
+			l.tail = prev
+		}
+		l.freeNode(curr)
+		deleted++
 	}
+	l.len -= deleted
+	return deleted
 }
 
 func (l *cslList[E]) Clear() {
+	if l.alloc != nil && l.len > 0 {
+		curr := l.tail.next
+		for range l.len {
+			next := curr.next
+			l.freeNode(curr)
+			curr = next
+		}
+	}
 	l.tail = nil
 	l.len = 0
 }
@@ -222,3 +324,26 @@ func (l *cslList[E]) Iter() iter.Seq2[int, E] {
 		}
 	}
 }
+
+// insertIndex maps an index of InsertRel on a list of length n to a
+// position for Insert. Indexes in [0, n] are kept, so n appends, and the
+// others wrap modulo n as in GetRel: -1 inserts before the last element and
+// -n-1 before the last one again. This differs from Python's list.insert,
+// which clamps indexes below -n to 0.
+func insertIndex(i, n int) int {
+	switch {
+	case n == 0:
+		return 0
+	case 0 <= i && i <= n:
+		return i
+	}
+	return wrapIndex(i, n)
+}
+
+func wrapIndex(i, n int) int {
+	i %= n
+	if i < 0 {
+		i += n
+	}
+	return i
+}
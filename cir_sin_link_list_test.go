@@ -4,10 +4,33 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 
 	"testing"
 )
 
+// testList is the method set shared by the circular lists, so that the same
+// test tables can be run against every implementation.
+type testList[L any] interface {
+	List[int]
+	Clone() L
+	Extend(es L)
+	At(i int) (int, bool)
+	MustGet(i int) int
+	MustInsert(v int, i int)
+	MustDelete(i int) int
+	GetRel(i int) (int, error)
+	InsertRel(v int, i int) error
+	DeleteRel(i int) (int, error)
+}
+
+// listString formats l like a cslList, so the expected strings of the tables
+// do not depend on the implementation name.
+func listString(l fmt.Stringer) string {
+	_, elems, _ := strings.Cut(l.String(), "{")
+	return "cslList{" + elems
+}
+
 func TestCSLListNewAndString(t *testing.T) {
 	tests := []struct {
 		name string
@@ -66,7 +89,7 @@ func TestCSLListNewAndString(t *testing.T) {
 	}
 }
 
-func TestCSLListLength(t *testing.T) {
+func testListLength[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name string
 		data []int
@@ -96,14 +119,14 @@ func TestCSLListLength(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewCSLList(tt.data...).Length(); got != tt.want {
-				t.Errorf("NewCSLList(%v).Length() = %v, want %v", tt.data, got, tt.want)
+			if got := newList(tt.data...).Length(); got != tt.want {
+				t.Errorf("Length() of %v = %v, want %v", tt.data, got, tt.want)
 			}
 		})
 	}
 }
 
-func TestCSLListAppend(t *testing.T) {
+func testListAppend[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name     string
 		init     []int
@@ -132,18 +155,18 @@ func TestCSLListAppend(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := NewCSLList(tt.init...)
+			l := newList(tt.init...)
 			for _, v := range tt.toAppend {
 				l.Append(v)
 			}
-			if got := l.String(); got != tt.want {
-				t.Errorf("NewCSLList().Append(...%v).String() = %v, want %v", tt.toAppend, got, tt.want)
+			if got := listString(l); got != tt.want {
+				t.Errorf("Append(...%v).String() = %v, want %v", tt.toAppend, got, tt.want)
 			}
 		})
 	}
 }
 
-func TestCSLListGet(t *testing.T) {
+func testListGet[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name     string
 		init     []int
@@ -217,10 +240,10 @@ func TestCSLListGet(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := NewCSLList(tt.init...)
+			l := newList(tt.init...)
 			gotVal, err := l.Get(tt.index)
 
-			if gotList := l.String(); gotList != tt.wantList {
+			if gotList := listString(l); gotList != tt.wantList {
 				t.Errorf("list after Get() = %v, want %v", gotList, tt.wantList)
 			}
 
@@ -239,7 +262,7 @@ func TestCSLListGet(t *testing.T) {
 	}
 }
 
-func TestCSLListInsert(t *testing.T) {
+func testListInsert[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name     string
 		init     []int
@@ -298,6 +321,18 @@ func TestCSLListInsert(t *testing.T) {
 			},
 			want: "cslList{ 0 1 1 2 3 }",
 		},
+		{
+			name: "insert into empty list",
+			init: []int{},
+			toInsert: []struct {
+				val int
+				idx int
+			}{
+				{1, 0},
+				{0, 0},
+			},
+			want: "cslList{ 0 1 }",
+		},
 		{
 			name: "insert out of bounds (negative)",
 			init: []int{1, 2, 3},
@@ -326,7 +361,7 @@ func TestCSLListInsert(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := NewCSLList(tt.init...)
+			l := newList(tt.init...)
 			var err error
 
 			for _, ins := range tt.toInsert {
@@ -335,18 +370,18 @@ func TestCSLListInsert(t *testing.T) {
 				}
 			}
 
-			if got := l.String(); got != tt.want {
-				t.Errorf("NewCSLList().Insert(...).String() = %v, want %v", got, tt.want)
+			if got := listString(l); got != tt.want {
+				t.Errorf("Insert(...).String() = %v, want %v", got, tt.want)
 			}
 
 			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
-				t.Errorf("NewCSLList().Insert(...) error = %v, want %v", err, tt.wantErr)
+				t.Errorf("Insert(...) error = %v, want %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
-func TestCSLListDelete(t *testing.T) {
+func testListDelete[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name      string
 		init      []int
@@ -421,7 +456,7 @@ func TestCSLListDelete(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := NewCSLList(tt.init...)
+			l := newList(tt.init...)
 			var gotVals []int
 			var err error
 
@@ -438,7 +473,7 @@ func TestCSLListDelete(t *testing.T) {
 				gotVals = append(gotVals, val)
 			}
 
-			if got := l.String(); got != tt.wantList {
+			if got := listString(l); got != tt.wantList {
 				t.Errorf("list after Delete() = %v, want %v", got, tt.wantList)
 			}
 
@@ -457,7 +492,7 @@ func TestCSLListDelete(t *testing.T) {
 	}
 }
 
-func TestCSLListDeleteAll(t *testing.T) {
+func testListDeleteAll[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name        string
 		init        []int
@@ -506,6 +541,18 @@ func TestCSLListDeleteAll(t *testing.T) {
 			deleteValue: 1,
 			want:        "cslList{ 2 3 }",
 		},
+		{
+			name:        "long run of matches",
+			init:        []int{1, 2, 2, 2, 2, 3},
+			deleteValue: 2,
+			want:        "cslList{ 1 3 }",
+		},
+		{
+			name:        "long run of matches at the end",
+			init:        []int{2, 1, 2, 2, 2, 2},
+			deleteValue: 2,
+			want:        "cslList{ 1 }",
+		},
 		{
 			name:        "zero value",
 			init:        []int{0, 1, 0, 2, 0},
@@ -516,18 +563,18 @@ func TestCSLListDeleteAll(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := NewCSLList(tt.init...)
+			l := newList(tt.init...)
 
 			l.DeleteAll(tt.deleteValue)
 
-			if got := l.String(); got != tt.want {
+			if got := listString(l); got != tt.want {
 				t.Errorf("DeleteAll() result = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestCSLListClone(t *testing.T) {
+func testListClone[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name       string
 		init       []int
@@ -596,7 +643,7 @@ func TestCSLListClone(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			orig := NewCSLList(tt.init...)
+			orig := newList(tt.init...)
 			copy := orig.Clone()
 
 			for _, v := range tt.modifyOrig {
@@ -611,22 +658,22 @@ func TestCSLListClone(t *testing.T) {
 				copy.Append(v)
 			}
 
-			if got := orig.String(); got != tt.wantOrig {
+			if got := listString(orig); got != tt.wantOrig {
 				t.Errorf("original list after modifications = %v, want %v", got, tt.wantOrig)
 			}
 
-			if got := copy.String(); got != tt.wantCopy {
+			if got := listString(copy); got != tt.wantCopy {
 				t.Errorf("copied list after modifications = %v, want %v", got, tt.wantCopy)
 			}
 
-			if len(tt.init) > 0 && orig == copy {
+			if len(tt.init) > 0 && any(orig) == any(copy) {
 				t.Error("original and copy reference the same list, want independent copies")
 			}
 		})
 	}
 }
 
-func TestCSLListExtend(t *testing.T) {
+func testListExtend[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name       string
 		init       []int
@@ -679,29 +726,29 @@ func TestCSLListExtend(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := NewCSLList(tt.init...)
+			l := newList(tt.init...)
 
-			var extendList *cslList[int]
+			var extendList L
 			if tt.extendWith != nil {
-				extendList = NewCSLList(tt.extendWith...)
+				extendList = newList(tt.extendWith...)
 			}
 
 			l.Extend(extendList)
 
-			if got := l.String(); got != tt.want {
+			if got := listString(l); got != tt.want {
 				t.Errorf("after Extend() = %v, want %v", got, tt.want)
 			}
 
-			if extendList != nil {
-				if got := extendList.String(); got != NewCSLList(tt.extendWith...).String() {
-					t.Errorf("extendList was modified, got %v, want %v", got, NewCSLList(tt.extendWith...).String())
+			if tt.extendWith != nil {
+				if got := listString(extendList); got != listString(newList(tt.extendWith...)) {
+					t.Errorf("extendList was modified, got %v, want %v", got, listString(newList(tt.extendWith...)))
 				}
 			}
 		})
 	}
 }
 
-func TestCSLListFindFirstLast(t *testing.T) {
+func testListFindFirstLast[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name      string
 		init      []int
@@ -783,7 +830,7 @@ func TestCSLListFindFirstLast(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := NewCSLList(tt.init...)
+			l := newList(tt.init...)
 
 			gotFirst := l.FindFirst(tt.searchVal)
 			if gotFirst != tt.wantFirst {
@@ -795,14 +842,14 @@ func TestCSLListFindFirstLast(t *testing.T) {
 				t.Errorf("FindLast(%v) = %v, want %v", tt.searchVal, gotLast, tt.wantLast)
 			}
 
-			if gotList := l.String(); gotList != NewCSLList(tt.init...).String() {
-				t.Errorf("list was modified, got %v, want %v", gotList, NewCSLList(tt.init...).String())
+			if gotList := listString(l); gotList != listString(newList(tt.init...)) {
+				t.Errorf("list was modified, got %v, want %v", gotList, listString(newList(tt.init...)))
 			}
 		})
 	}
 }
 
-func TestCSLListReverse(t *testing.T) {
+func testListReverse[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name string
 		init []int
@@ -852,22 +899,22 @@ func TestCSLListReverse(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := NewCSLList(tt.init...)
+			l := newList(tt.init...)
 			l.Reverse()
 
-			if got := l.String(); got != tt.want {
+			if got := listString(l); got != tt.want {
 				t.Errorf("Reverse() = %v, want %v", got, tt.want)
 			}
 
 			l.Reverse()
-			if got := l.String(); got != NewCSLList(tt.init...).String() {
-				t.Errorf("Double Reverse() = %v, want original %v", got, NewCSLList(tt.init...).String())
+			if got := listString(l); got != listString(newList(tt.init...)) {
+				t.Errorf("Double Reverse() = %v, want original %v", got, listString(newList(tt.init...)))
 			}
 		})
 	}
 }
 
-func TestCSLListClear(t *testing.T) {
+func testListClear[L testList[L]](t *testing.T, newList func(...int) L) {
 	tests := []struct {
 		name string
 		init []int
@@ -896,28 +943,28 @@ func TestCSLListClear(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := NewCSLList(tt.init...)
+			l := newList(tt.init...)
 
 			l.Clear()
 
-			if got := l.String(); got != "cslList{  }" {
+			if got := listString(l); got != "cslList{  }" {
 				t.Errorf("Clear() result = %v, want empty list", got)
 			}
 
 			l.Append(1)
-			if got := l.String(); got != "cslList{ 1 }" {
+			if got := listString(l); got != "cslList{ 1 }" {
 				t.Errorf("List not reusable after Clear(), got %v", got)
 			}
 
 			l.Clear()
-			if got := l.String(); got != "cslList{  }" {
+			if got := listString(l); got != "cslList{  }" {
 				t.Errorf("Second Clear() failed, got %v", got)
 			}
 		})
 	}
 }
 
-func TestCSLListIter(t *testing.T) {
+func testListIter[L testList[L]](t *testing.T, newList func(...int) L) {
 	type pair struct {
 		index int
 		value int
@@ -960,7 +1007,7 @@ func TestCSLListIter(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			l := NewCSLList(tt.init...)
+			l := newList(tt.init...)
 
 			var got []pair
 			for idx, val := range l.Iter() {
@@ -971,7 +1018,7 @@ func TestCSLListIter(t *testing.T) {
 				t.Errorf("Iter() produced %v, want %v", got, tt.want)
 			}
 
-			if gotList := l.String(); gotList != NewCSLList(tt.init...).String() {
+			if gotList := listString(l); gotList != listString(newList(tt.init...)) {
 				t.Errorf("list was modified during iteration, got %v", gotList)
 			}
 
@@ -988,7 +1035,7 @@ func TestCSLListIter(t *testing.T) {
 	}
 
 	t.Run("concurrent modification detection", func(t *testing.T) {
-		l := NewCSLList(1, 2, 3)
+		l := newList(1, 2, 3)
 
 		var got []pair
 		i := 0
@@ -1006,3 +1053,440 @@ func TestCSLListIter(t *testing.T) {
 		}
 	})
 }
+
+func testListAt[L testList[L]](t *testing.T, newList func(...int) L) {
+	tests := []struct {
+		name    string
+		init    []int
+		index   int
+		wantVal int
+		wantOk  bool
+	}{
+		{
+			name:    "first element",
+			init:    []int{10, 20, 30},
+			index:   0,
+			wantVal: 10,
+			wantOk:  true,
+		},
+		{
+			name:    "last element",
+			init:    []int{10, 20, 30},
+			index:   2,
+			wantVal: 30,
+			wantOk:  true,
+		},
+		{
+			name:    "empty list",
+			init:    []int{},
+			index:   0,
+			wantVal: 0,
+			wantOk:  false,
+		},
+		{
+			name:    "negative index",
+			init:    []int{10, 20, 30},
+			index:   -1,
+			wantVal: 0,
+			wantOk:  false,
+		},
+		{
+			name:    "index equals length",
+			init:    []int{10, 20, 30},
+			index:   3,
+			wantVal: 0,
+			wantOk:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newList(tt.init...)
+			gotVal, gotOk := l.At(tt.index)
+			if gotVal != tt.wantVal || gotOk != tt.wantOk {
+				t.Errorf("At(%d) = (%v, %v), want (%v, %v)", tt.index, gotVal, gotOk, tt.wantVal, tt.wantOk)
+			}
+		})
+	}
+}
+
+func testListMust[L testList[L]](t *testing.T, newList func(...int) L) {
+	catch := func(f func()) (r any) {
+		defer func() { r = recover() }()
+		f()
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		init    []int
+		op      func(l L) int
+		wantVal int
+		want    string
+		wantErr error
+		wantMsg string
+	}{
+		{
+			name:    "get valid",
+			init:    []int{1, 2, 3},
+			op:      func(l L) int { return l.MustGet(1) },
+			wantVal: 2,
+			want:    "cslList{ 1 2 3 }",
+		},
+		{
+			name:    "get out of range",
+			init:    []int{1, 2, 3},
+			op:      func(l L) int { return l.MustGet(3) },
+			want:    "cslList{ 1 2 3 }",
+			wantErr: ErrIndexOutOfRange,
+			wantMsg: "index out of range [3] with length 3",
+		},
+		{
+			name: "insert valid",
+			init: []int{1, 2, 3},
+			op:   func(l L) int { l.MustInsert(9, 3); return 0 },
+			want: "cslList{ 1 2 3 9 }",
+		},
+		{
+			name:    "insert out of bounds",
+			init:    []int{1, 2, 3},
+			op:      func(l L) int { l.MustInsert(9, -1); return 0 },
+			want:    "cslList{ 1 2 3 }",
+			wantErr: ErrListBounds,
+			wantMsg: "list bounds out of range [-1:]",
+		},
+		{
+			name:    "delete valid",
+			init:    []int{1, 2, 3},
+			op:      func(l L) int { return l.MustDelete(0) },
+			wantVal: 1,
+			want:    "cslList{ 2 3 }",
+		},
+		{
+			name:    "delete from empty list",
+			init:    []int{},
+			op:      func(l L) int { return l.MustDelete(0) },
+			want:    "cslList{  }",
+			wantErr: ErrIndexOutOfRange,
+			wantMsg: "index out of range [0] with length 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newList(tt.init...)
+			var gotVal int
+			r := catch(func() { gotVal = tt.op(l) })
+
+			if got := listString(l); got != tt.want {
+				t.Errorf("list after Must*() = %v, want %v", got, tt.want)
+			}
+
+			if tt.wantErr == nil {
+				if r != nil {
+					t.Errorf("unexpected panic: %v", r)
+				}
+				if gotVal != tt.wantVal {
+					t.Errorf("Must*() returned value = %v, want %v", gotVal, tt.wantVal)
+				}
+				return
+			}
+
+			err, ok := r.(error)
+			if !ok || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Must*() panicked with %v, want %v", r, tt.wantErr)
+			}
+			if err.Error() != tt.wantMsg {
+				t.Errorf("panic message = %q, want %q", err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func testListRel[L testList[L]](t *testing.T, newList func(...int) L) {
+	tests := []struct {
+		name    string
+		init    []int
+		op      func(l L) (int, error)
+		wantVal int
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "get last",
+			init:    []int{1, 2, 3},
+			op:      func(l L) (int, error) { return l.GetRel(-1) },
+			wantVal: 3,
+			want:    "cslList{ 1 2 3 }",
+		},
+		{
+			name:    "get first from the end",
+			init:    []int{1, 2, 3},
+			op:      func(l L) (int, error) { return l.GetRel(-3) },
+			wantVal: 1,
+			want:    "cslList{ 1 2 3 }",
+		},
+		{
+			name:    "get wraps around",
+			init:    []int{1, 2, 3},
+			op:      func(l L) (int, error) { return l.GetRel(7) },
+			wantVal: 2,
+			want:    "cslList{ 1 2 3 }",
+		},
+		{
+			name:    "get negative wraps around",
+			init:    []int{1, 2, 3},
+			op:      func(l L) (int, error) { return l.GetRel(-5) },
+			wantVal: 2,
+			want:    "cslList{ 1 2 3 }",
+		},
+		{
+			name:    "get from empty list",
+			init:    []int{},
+			op:      func(l L) (int, error) { return l.GetRel(-1) },
+			want:    "cslList{  }",
+			wantErr: ErrIndexOutOfRange,
+		},
+		{
+			name:    "delete last",
+			init:    []int{1, 2, 3},
+			op:      func(l L) (int, error) { return l.DeleteRel(-1) },
+			wantVal: 3,
+			want:    "cslList{ 1 2 }",
+		},
+		{
+			name:    "delete wraps around",
+			init:    []int{1, 2, 3},
+			op:      func(l L) (int, error) { return l.DeleteRel(3) },
+			wantVal: 1,
+			want:    "cslList{ 2 3 }",
+		},
+		{
+			name:    "delete from empty list",
+			init:    []int{},
+			op:      func(l L) (int, error) { return l.DeleteRel(0) },
+			want:    "cslList{  }",
+			wantErr: ErrIndexOutOfRange,
+		},
+		{
+			name: "insert at the end",
+			init: []int{1, 2, 3},
+			op:   func(l L) (int, error) { return 0, l.InsertRel(4, 3) },
+			want: "cslList{ 1 2 3 4 }",
+		},
+		{
+			name: "insert before last",
+			init: []int{1, 2, 3},
+			op:   func(l L) (int, error) { return 0, l.InsertRel(4, -1) },
+			want: "cslList{ 1 2 4 3 }",
+		},
+		{
+			name: "insert before second to last",
+			init: []int{1, 2, 3},
+			op:   func(l L) (int, error) { return 0, l.InsertRel(4, -2) },
+			want: "cslList{ 1 4 2 3 }",
+		},
+		{
+			name: "insert wraps around",
+			init: []int{1, 2, 3},
+			op:   func(l L) (int, error) { return 0, l.InsertRel(4, 5) },
+			want: "cslList{ 1 2 4 3 }",
+		},
+		{
+			name: "insert below minus length wraps around",
+			init: []int{1, 2, 3},
+			op:   func(l L) (int, error) { return 0, l.InsertRel(4, -4) },
+			want: "cslList{ 1 2 4 3 }",
+		},
+		{
+			name: "insert into empty list",
+			init: []int{},
+			op:   func(l L) (int, error) { return 0, l.InsertRel(4, -7) },
+			want: "cslList{ 4 }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newList(tt.init...)
+			gotVal, err := tt.op(l)
+
+			if got := listString(l); got != tt.want {
+				t.Errorf("list after *Rel() = %v, want %v", got, tt.want)
+			}
+
+			if gotVal != tt.wantVal {
+				t.Errorf("*Rel() returned value = %v, want %v", gotVal, tt.wantVal)
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("*Rel() error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected *Rel() error = %v", err)
+			}
+		})
+	}
+}
+
+func testListDeleteTail[L testList[L]](t *testing.T, newList func(...int) L) {
+	tests := []struct {
+		name   string
+		init   []int
+		delete func(l L)
+		want   string
+	}{
+		{
+			name:   "delete last then append",
+			init:   []int{1, 2, 3},
+			delete: func(l L) { l.Delete(2) },
+			want:   "cslList{ 1 2 4 }",
+		},
+		{
+			name:   "delete only element then append",
+			init:   []int{1},
+			delete: func(l L) { l.Delete(0) },
+			want:   "cslList{ 4 }",
+		},
+		{
+			name:   "delete all at the end then append",
+			init:   []int{1, 2, 3, 3},
+			delete: func(l L) { l.DeleteAll(3) },
+			want:   "cslList{ 1 2 4 }",
+		},
+		{
+			name:   "delete all elements then append",
+			init:   []int{3, 3},
+			delete: func(l L) { l.DeleteAll(3) },
+			want:   "cslList{ 4 }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newList(tt.init...)
+			tt.delete(l)
+			l.Append(4)
+
+			if got := listString(l); got != tt.want {
+				t.Errorf("list after delete and Append() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSLListLength(t *testing.T) {
+	testListLength(t, NewCSLList[int])
+}
+
+func TestCSLListAppend(t *testing.T) {
+	testListAppend(t, NewCSLList[int])
+}
+
+func TestCSLListGet(t *testing.T) {
+	testListGet(t, NewCSLList[int])
+}
+
+func TestCSLListInsert(t *testing.T) {
+	testListInsert(t, NewCSLList[int])
+}
+
+func TestCSLListDelete(t *testing.T) {
+	testListDelete(t, NewCSLList[int])
+}
+
+func TestCSLListDeleteAll(t *testing.T) {
+	testListDeleteAll(t, NewCSLList[int])
+}
+
+func TestCSLListClone(t *testing.T) {
+	testListClone(t, NewCSLList[int])
+}
+
+func TestCSLListExtend(t *testing.T) {
+	testListExtend(t, NewCSLList[int])
+}
+
+func TestCSLListFindFirstLast(t *testing.T) {
+	testListFindFirstLast(t, NewCSLList[int])
+}
+
+func TestCSLListReverse(t *testing.T) {
+	testListReverse(t, NewCSLList[int])
+}
+
+func TestCSLListClear(t *testing.T) {
+	testListClear(t, NewCSLList[int])
+}
+
+func TestCSLListIter(t *testing.T) {
+	testListIter(t, NewCSLList[int])
+}
+
+func TestCSLListAt(t *testing.T) {
+	testListAt(t, NewCSLList[int])
+}
+
+func TestCSLListMust(t *testing.T) {
+	testListMust(t, NewCSLList[int])
+}
+
+func TestCSLListRel(t *testing.T) {
+	testListRel(t, NewCSLList[int])
+}
+
+func TestCSLListDeleteTail(t *testing.T) {
+	testListDeleteTail(t, NewCSLList[int])
+}
+
+func TestCSLListDistinct(t *testing.T) {
+	tests := []struct {
+		name        string
+		init        []int
+		want        string
+		wantDeleted int
+	}{
+		{
+			name: "empty",
+			want: "cslList{ 9 }",
+		},
+		{
+			name: "no duplicates",
+			init: []int{3, 1, 2},
+			want: "cslList{ 3 1 2 9 }",
+		},
+		{
+			name:        "keeps first occurrences",
+			init:        []int{1, 2, 1, 3, 2, 4},
+			want:        "cslList{ 1 2 3 4 9 }",
+			wantDeleted: 2,
+		},
+		{
+			name:        "duplicate tail",
+			init:        []int{1, 2, 2, 1},
+			want:        "cslList{ 1 2 9 }",
+			wantDeleted: 2,
+		},
+		{
+			name:        "all equal",
+			init:        []int{5, 5, 5},
+			want:        "cslList{ 5 9 }",
+			wantDeleted: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewCSLList(tt.init...)
+			if got := l.Distinct(); got != tt.wantDeleted {
+				t.Errorf("Distinct() = %d, want %d", got, tt.wantDeleted)
+			}
+			// appending checks that the tail was kept
+			l.Append(9)
+			if got := l.String(); got != tt.want {
+				t.Errorf("list after Distinct() and Append(9) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package ds
+
+import (
+	"fmt"
+	"iter"
+)
+
+const unrolledNodeCap = 64
+
+type unrolledNode[E comparable] struct {
+	data [unrolledNodeCap]E
+	n    int
+	next *unrolledNode[E]
+}
+
+// UnrolledList is a circular singly linked list whose nodes hold up to
+// unrolledNodeCap elements, so positional access walks blocks instead of
+// single elements.
+type UnrolledList[E comparable] struct {
+	tail *unrolledNode[E]
+	len  int
+}
+
+var _ List[int] = (*UnrolledList[int])(nil)
+
+func NewUnrolledList[E comparable](vs ...E) *UnrolledList[E] {
+	l := &UnrolledList[E]{}
+	for _, v := range vs {
+		l.Append(v)
+	}
+	return l
+}
+
+func (l *UnrolledList[E]) String() string {
+	return formatList("UnrolledList", l.len, l.Iter())
+}
+
+func (l *UnrolledList[E]) Length() int {
+	return l.len
+}
+
+func (l *UnrolledList[E]) Clone() *UnrolledList[E] {
+	c := &UnrolledList[E]{}
+	c.tail = cloneUnrolledNodes(l)
+	c.len = l.len
+	return c
+}
+
+func cloneUnrolledNodes[E comparable](l *UnrolledList[E]) *unrolledNode[E] {
+	if l.len == 0 {
+		return nil
+	}
+	tail := &unrolledNode[E]{}
+	*tail = *l.tail
+	currCopy := tail
+	curr := l.tail
+	for curr.next != l.tail {
+		curr = curr.next
+		currCopy.next = &unrolledNode[E]{}
+		*currCopy.next = *curr
+		currCopy = currCopy.next
+	}
+	currCopy.next = tail
+	return tail
+}
+
+// find returns the block holding the i-th element, its predecessor and the
+// offset of the element inside the block. For i == l.len it returns the tail
+// block and the offset right after its last element.
+func (l *UnrolledList[E]) find(i int) (prev, b *unrolledNode[E], off int) {
+	prev, b = l.tail, l.tail.next
+	for i >= b.n && b != l.tail {
+		i -= b.n
+		prev, b = b, b.next
+	}
+	return prev, b, i
+}
+
+func (l *UnrolledList[E]) Get(i int) (E, error) {
+	var zero E
+	if i < 0 {
+		return zero, fmt.Errorf("%w [%d]", ErrIndexOutOfRange, i)
+	}
+	if i >= l.len {
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	_, b, off := l.find(i)
+	return b.data[off], nil
+}
+
+func (l *UnrolledList[E]) MustGet(i int) E {
+	v, err := l.Get(i)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (l *UnrolledList[E]) GetRel(i int) (E, error) {
+	if l.len == 0 {
+		var zero E
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	return l.Get(wrapIndex(i, l.len))
+}
+
+func (l *UnrolledList[E]) At(i int) (E, bool) {
+	if i < 0 || i >= l.len {
+		var zero E
+		return zero, false
+	}
+	_, b, off := l.find(i)
+	return b.data[off], true
+}
+
+func (l *UnrolledList[E]) FindFirst(v E) int {
+	for i, val := range l.Iter() {
+		if val == v {
+			return i
+		}
+	}
+	return -1
+}
+
+func (l *UnrolledList[E]) FindLast(v E) int {
+	last := -1
+	for i, val := range l.Iter() {
+		if val == v {
+			last = i
+		}
+	}
+	return last
+}
+
+func (l *UnrolledList[E]) Append(v E) {
+	if l.len == 0 {
+		b := &unrolledNode[E]{}
+		b.next = b
+		l.tail = b
+	} else if l.tail.n == unrolledNodeCap {
+		b := &unrolledNode[E]{next: l.tail.next}
+		l.tail.next = b
+		l.tail = b
+	}
+	l.tail.data[l.tail.n] = v
+	l.tail.n++
+	l.len++
+}
+
+func (l *UnrolledList[E]) Insert(v E, i int) error {
+	if i < 0 {
+		return fmt.Errorf("%w [%d:]", ErrListBounds, i)
+	}
+	if i > l.len {
+		return fmt.Errorf("%w [%d:%d]", ErrListBounds, i, l.len)
+	}
+	if i == l.len {
+		l.Append(v)
+		return nil
+	}
+
+	_, b, off := l.find(i)
+	if b.n == unrolledNodeCap {
+		half := unrolledNodeCap / 2
+		nb := &unrolledNode[E]{next: b.next}
+		nb.n = copy(nb.data[:], b.data[half:])
+		clear(b.data[half:])
+		b.n = half
+		b.next = nb
+		if b == l.tail {
+			l.tail = nb
+		}
+		if off > half {
+			b, off = nb, off-half
+		}
+	}
+	copy(b.data[off+1:b.n+1], b.data[off:b.n])
+	b.data[off] = v
+	b.n++
+	l.len++
+	return nil
+}
+
+func (l *UnrolledList[E]) InsertRel(v E, i int) error {
+	return l.Insert(v, insertIndex(i, l.len))
+}
+
+func (l *UnrolledList[E]) MustInsert(v E, i int) {
+	if err := l.Insert(v, i); err != nil {
+		panic(err)
+	}
+}
+
+func (l *UnrolledList[E]) Extend(es *UnrolledList[E]) {
+	if es == nil || es.len == 0 {
+		return
+	}
+	tail := cloneUnrolledNodes(es)
+	if l.len == 0 {
+		l.tail, l.len = tail, es.len
+		return
+	}
+
+	l.tail.next, tail.next, l.tail = tail.next, l.tail.next, tail
+	l.len += es.len
+}
+
+func (l *UnrolledList[E]) Reverse() {
+	if l.len == 0 {
+		return
+	}
+	head := l.tail.next
+	prev := l.tail
+	curr := head
+	for {
+		next := curr.next
+		curr.next = prev
+		for j, k := 0, curr.n-1; j < k; j, k = j+1, k-1 {
+			curr.data[j], curr.data[k] = curr.data[k], curr.data[j]
+		}
+		if curr == l.tail {
+			break
+		}
+		prev, curr = curr, next
+	}
+	l.tail = head
+}
+
+func (l *UnrolledList[E]) Delete(i int) (E, error) {
+	var zero E
+	if i < 0 {
+		return zero, fmt.Errorf("%w [%d]", ErrIndexOutOfRange, i)
+	}
+	if i >= l.len {
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+
+	prev, b, off := l.find(i)
+	v := b.data[off]
+	copy(b.data[off:b.n-1], b.data[off+1:b.n])
+	b.n--
+	b.data[b.n] = zero
+	l.len--
+
+	switch {
+	case l.len == 0:
+		l.tail = nil
+	case b.n == 0:
+		l.unlink(prev, b)
+	case b != l.tail && b.n+b.next.n <= unrolledNodeCap/2:
+		l.merge(b)
+	}
+	return v, nil
+}
+
+func (l *UnrolledList[E]) DeleteRel(i int) (E, error) {
+	if l.len == 0 {
+		var zero E
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	return l.Delete(wrapIndex(i, l.len))
+}
+
+func (l *UnrolledList[E]) MustDelete(i int) E {
+	v, err := l.Delete(i)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// unlink removes the empty block b that follows prev.
+func (l *UnrolledList[E]) unlink(prev, b *unrolledNode[E]) {
+	prev.next = b.next
+	if b == l.tail {
+		l.tail = prev
+	}
+}
+
+// merge moves the elements of the block after b into b and removes it.
+func (l *UnrolledList[E]) merge(b *unrolledNode[E]) {
+	next := b.next
+	copy(b.data[b.n:], next.data[:next.n])
+	b.n += next.n
+	l.unlink(b, next)
+}
+
+func (l *UnrolledList[E]) DeleteAll(v E) {
+	if l.len == 0 {
+		return
+	}
+	prev, b := l.tail, l.tail.next
+	for {
+		last := b == l.tail
+		n := 0
+		for _, val := range b.data[:b.n] {
+			if val != v {
+				b.data[n] = val
+				n++
+			}
+		}
+		clear(b.data[n:b.n])
+		l.len -= b.n - n
+		b.n = n
+
+		if b.n == 0 {
+			l.unlink(prev, b)
+		} else {
+			prev = b
+		}
+		if last {
+			break
+		}
+		b = b.next
+	}
+	if l.len == 0 {
+		l.tail = nil
+	}
+}
+
+func (l *UnrolledList[E]) Clear() {
+	l.tail = nil
+	l.len = 0
+}
+
+func (l *UnrolledList[E]) Iter() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		if l.len == 0 {
+			return
+		}
+		i := 0
+		b := l.tail
+		for n := l.len; i < n; {
+			b = b.next
+			if b.n == 0 {
+				return
+			}
+			for _, v := range b.data[:b.n] {
+				if i == n || !yield(i, v) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package ds
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestUnrolledListOperations(t *testing.T) {
+	tests := []struct {
+		name    string
+		init    []int
+		op      func(l *UnrolledList[int]) error
+		want    string
+		wantErr error
+	}{
+		{
+			name: "empty",
+			init: []int{},
+			op:   func(l *UnrolledList[int]) error { return nil },
+			want: "UnrolledList{  }",
+		},
+		{
+			name: "append",
+			init: []int{1, 2},
+			op:   func(l *UnrolledList[int]) error { l.Append(3); return nil },
+			want: "UnrolledList{ 1 2 3 }",
+		},
+		{
+			name: "insert in middle",
+			init: []int{1, 3},
+			op:   func(l *UnrolledList[int]) error { return l.Insert(2, 1) },
+			want: "UnrolledList{ 1 2 3 }",
+		},
+		{
+			name:    "insert out of bounds",
+			init:    []int{1, 3},
+			op:      func(l *UnrolledList[int]) error { return l.Insert(2, 3) },
+			want:    "UnrolledList{ 1 3 }",
+			wantErr: ErrListBounds,
+		},
+		{
+			name: "delete last then append",
+			init: []int{1, 2, 3},
+			op: func(l *UnrolledList[int]) error {
+				_, err := l.DeleteRel(-1)
+				l.Append(4)
+				return err
+			},
+			want: "UnrolledList{ 1 2 4 }",
+		},
+		{
+			name: "delete from empty list",
+			init: []int{},
+			op: func(l *UnrolledList[int]) error {
+				_, err := l.Delete(0)
+				return err
+			},
+			want:    "UnrolledList{  }",
+			wantErr: ErrIndexOutOfRange,
+		},
+		{
+			name: "delete all",
+			init: []int{1, 2, 1, 3, 1},
+			op:   func(l *UnrolledList[int]) error { l.DeleteAll(1); return nil },
+			want: "UnrolledList{ 2 3 }",
+		},
+		{
+			name: "reverse",
+			init: []int{1, 2, 3, 4},
+			op:   func(l *UnrolledList[int]) error { l.Reverse(); return nil },
+			want: "UnrolledList{ 4 3 2 1 }",
+		},
+		{
+			name: "extend with clone of itself",
+			init: []int{1, 2},
+			op:   func(l *UnrolledList[int]) error { l.Extend(l.Clone()); return nil },
+			want: "UnrolledList{ 1 2 1 2 }",
+		},
+		{
+			name: "clear",
+			init: []int{1, 2},
+			op:   func(l *UnrolledList[int]) error { l.Clear(); return nil },
+			want: "UnrolledList{  }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewUnrolledList(tt.init...)
+			err := tt.op(l)
+
+			if got := l.String(); got != tt.want {
+				t.Errorf("list after operation = %v, want %v", got, tt.want)
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("operation error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected operation error = %v", err)
+			}
+		})
+	}
+}
+
+func TestUnrolledListModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	l := NewUnrolledList[int]()
+	var model []int
+
+	for step := range 20000 {
+		switch op := r.IntN(10); {
+		case op < 3:
+			v := r.IntN(50)
+			l.Append(v)
+			model = append(model, v)
+		case op < 6:
+			v, i := r.IntN(50), r.IntN(len(model)+1)
+			if err := l.Insert(v, i); err != nil {
+				t.Fatalf("step %d: Insert(%d, %d) error = %v", step, v, i, err)
+			}
+			model = slices.Insert(model, i, v)
+		case op < 9:
+			if len(model) == 0 {
+				continue
+			}
+			i := r.IntN(len(model))
+			got, err := l.Delete(i)
+			if err != nil || got != model[i] {
+				t.Fatalf("step %d: Delete(%d) = (%v, %v), want (%v, nil)", step, i, got, err, model[i])
+			}
+			model = slices.Delete(model, i, i+1)
+		default:
+			v := r.IntN(50)
+			l.DeleteAll(v)
+			model = slices.DeleteFunc(model, func(e int) bool { return e == v })
+		}
+
+		if l.Length() != len(model) {
+			t.Fatalf("step %d: Length() = %d, want %d", step, l.Length(), len(model))
+		}
+		if len(model) > 0 {
+			i := r.IntN(len(model))
+			if got := l.MustGet(i); got != model[i] {
+				t.Fatalf("step %d: Get(%d) = %v, want %v", step, i, got, model[i])
+			}
+		}
+		if step%500 == 0 {
+			var got []int
+			for _, v := range l.Iter() {
+				got = append(got, v)
+			}
+			if !slices.Equal(got, model) {
+				t.Fatalf("step %d: Iter() produced %v, want %v", step, got, model)
+			}
+		}
+	}
+
+	l.Reverse()
+	slices.Reverse(model)
+	for i, v := range model {
+		if got, _ := l.At(i); got != v {
+			t.Fatalf("after Reverse() At(%d) = %v, want %v", i, got, v)
+		}
+	}
+}
+
+func BenchmarkUnrolledListGet(b *testing.B) {
+	l := NewUnrolledList[int]()
+	for i := range 100000 {
+		l.Append(i)
+	}
+	for i := 0; b.Loop(); i++ {
+		l.Get(i * 7919 % 100000)
+	}
+}
+
+func BenchmarkCSLListGet(b *testing.B) {
+	l := NewCSLList[int]()
+	for i := range 100000 {
+		l.Append(i)
+	}
+	for i := 0; b.Loop(); i++ {
+		l.Get(i * 7919 % 100000)
+	}
+}
+
+func BenchmarkUnrolledListInsertDelete(b *testing.B) {
+	l := NewUnrolledList[int]()
+	for i := range 100000 {
+		l.Append(i)
+	}
+	for i := 0; b.Loop(); i++ {
+		idx := i * 7919 % 100000
+		l.Insert(i, idx)
+		l.Delete(idx)
+	}
+}
+
+func BenchmarkCSLListInsertDelete(b *testing.B) {
+	l := NewCSLList[int]()
+	for i := range 100000 {
+		l.Append(i)
+	}
+	for i := 0; b.Loop(); i++ {
+		idx := i * 7919 % 100000
+		l.Insert(i, idx)
+		l.Delete(idx)
+	}
+}
+
+func TestUnrolledListLength(t *testing.T) {
+	testListLength(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListAppend(t *testing.T) {
+	testListAppend(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListGet(t *testing.T) {
+	testListGet(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListInsert(t *testing.T) {
+	testListInsert(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListDelete(t *testing.T) {
+	testListDelete(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListDeleteAll(t *testing.T) {
+	testListDeleteAll(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListClone(t *testing.T) {
+	testListClone(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListExtend(t *testing.T) {
+	testListExtend(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListFindFirstLast(t *testing.T) {
+	testListFindFirstLast(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListReverse(t *testing.T) {
+	testListReverse(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListClear(t *testing.T) {
+	testListClear(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListIter(t *testing.T) {
+	testListIter(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListAt(t *testing.T) {
+	testListAt(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListMust(t *testing.T) {
+	testListMust(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListRel(t *testing.T) {
+	testListRel(t, NewUnrolledList[int])
+}
+
+func TestUnrolledListDeleteTail(t *testing.T) {
+	testListDeleteTail(t, NewUnrolledList[int])
+}
@@ -102,4 +102,34 @@ func main() {
 	fmt.Println("Clearing")
 	l.Clear()
 	fmt.Println(l)
+
+	s := ds.NewStack(1, 2, 3)
+	fmt.Println(s)
+	fmt.Println("Pushing 4")
+	s.Push(4)
+	fmt.Println(s)
+	fmt.Println("Popping twice")
+	for range 2 {
+		if v, ok := s.Pop(); ok {
+			fmt.Println("popped:", v)
+		}
+	}
+	top, _ := s.Peek()
+	fmt.Println("top:", top)
+	fmt.Println(s)
+
+	q := ds.NewQueue(1, 2, 3)
+	fmt.Println(q)
+	fmt.Println("Pushing 4")
+	q.Push(4)
+	fmt.Println(q)
+	fmt.Println("Popping twice")
+	for range 2 {
+		if v, ok := q.Pop(); ok {
+			fmt.Println("popped:", v)
+		}
+	}
+	front, _ := q.Peek()
+	fmt.Println("front:", front)
+	fmt.Println(q)
 }
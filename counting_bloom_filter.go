@@ -0,0 +1,141 @@
+package ds
+
+import (
+	"encoding"
+	"math"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*CountingBloomFilter)(nil)
+	_ encoding.BinaryUnmarshaler = (*CountingBloomFilter)(nil)
+)
+
+// CountingBloomFilter is a BloomFilter with a counter in place of every bit,
+// so items can be removed. A counter that reaches 255 sticks there.
+type CountingBloomFilter struct {
+	counts []uint8
+	m      uint64
+	k      int
+}
+
+// NewCountingBloomFilter returns a filter that holds n items with a false
+// positive rate of about p. p is clamped to [1e-12, 0.5].
+func NewCountingBloomFilter(n int, p float64) *CountingBloomFilter {
+	m, k := bloomSize(n, p)
+	return &CountingBloomFilter{counts: make([]uint8, m), m: m, k: k}
+}
+
+// Counters returns the number of counters of the filter.
+func (f *CountingBloomFilter) Counters() int {
+	return int(f.m)
+}
+
+// Hashes returns the number of counters incremented for every item.
+func (f *CountingBloomFilter) Hashes() int {
+	return f.k
+}
+
+func (f *CountingBloomFilter) Add(data []byte) {
+	bloomIndexes(data, f.m, f.k, func(i uint64) bool {
+		if f.counts[i] < math.MaxUint8 {
+			f.counts[i]++
+		}
+		return true
+	})
+}
+
+func (f *CountingBloomFilter) AddString(s string) {
+	f.Add([]byte(s))
+}
+
+// Remove takes one occurrence of data out of the filter. It reports false
+// and changes nothing if data is certainly not in the filter. Removing an
+// item that was not added may remove others.
+func (f *CountingBloomFilter) Remove(data []byte) bool {
+	if !f.Contains(data) {
+		return false
+	}
+	bloomIndexes(data, f.m, f.k, func(i uint64) bool {
+		if f.counts[i] < math.MaxUint8 {
+			f.counts[i]--
+		}
+		return true
+	})
+	return true
+}
+
+func (f *CountingBloomFilter) RemoveString(s string) bool {
+	return f.Remove([]byte(s))
+}
+
+func (f *CountingBloomFilter) Contains(data []byte) bool {
+	ok := true
+	bloomIndexes(data, f.m, f.k, func(i uint64) bool {
+		ok = f.counts[i] != 0
+		return ok
+	})
+	return ok
+}
+
+func (f *CountingBloomFilter) ContainsString(s string) bool {
+	return f.Contains([]byte(s))
+}
+
+// ApproxLen estimates the number of items added and not removed from the
+// sum of the counters.
+func (f *CountingBloomFilter) ApproxLen() int {
+	sum := 0
+	for _, c := range f.counts {
+		sum += int(c)
+	}
+	return sum / f.k
+}
+
+func (f *CountingBloomFilter) Clear() {
+	clear(f.counts)
+}
+
+func (f *CountingBloomFilter) Clone() *CountingBloomFilter {
+	c := *f
+	c.counts = append([]uint8(nil), f.counts...)
+	return &c
+}
+
+// Union adds the items of o to f by adding up the counters. Both filters
+// must have the same number of counters and hashes.
+func (f *CountingBloomFilter) Union(o *CountingBloomFilter) error {
+	if f.m != o.m || f.k != o.k {
+		return ErrBloomMismatch
+	}
+	for i, c := range o.counts {
+		f.counts[i] = uint8(min(int(f.counts[i])+int(c), math.MaxUint8))
+	}
+	return nil
+}
+
+// BloomFilter returns a plain filter with the bits of the non-zero counters
+// set. It holds the same items as f.
+func (f *CountingBloomFilter) BloomFilter() *BloomFilter {
+	b := newBloomFilter(f.m, f.k)
+	for i, c := range f.counts {
+		if c != 0 {
+			b.bits[i/64] |= 1 << (i % 64)
+		}
+	}
+	return b
+}
+
+func (f *CountingBloomFilter) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, bloomHeader+len(f.counts))
+	b = appendBloomHeader(b, countingBloomKind, f.m, f.k)
+	return append(b, f.counts...), nil
+}
+
+func (f *CountingBloomFilter) UnmarshalBinary(data []byte) error {
+	m, k, data, err := readBloomHeader(data, countingBloomKind, func(m uint64) uint64 { return m })
+	if err != nil {
+		return err
+	}
+	*f = CountingBloomFilter{counts: append([]uint8(nil), data...), m: m, k: k}
+	return nil
+}
@@ -0,0 +1,113 @@
+package ds
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestCountingBloomFilterFalsePositiveRate(t *testing.T) {
+	for _, p := range []float64{0.1, 0.01, 0.001} {
+		t.Run(strconv.FormatFloat(p, 'g', -1, 64), func(t *testing.T) {
+			f := NewCountingBloomFilter(10000, p)
+			testFalsePositiveRate(t, 10000, p, f.AddString, f.ContainsString)
+		})
+	}
+}
+
+func TestCountingBloomFilterRemove(t *testing.T) {
+	f := NewCountingBloomFilter(1000, 0.01)
+	for i := range 1000 {
+		f.AddString(strconv.Itoa(i))
+	}
+	f.AddString("twice")
+	f.AddString("twice")
+	if got := f.ApproxLen(); got != 1002 {
+		t.Errorf("ApproxLen() = %d, want 1002", got)
+	}
+
+	for i := range 500 {
+		if k := strconv.Itoa(i); !f.RemoveString(k) {
+			t.Fatalf("RemoveString(%s) = false", k)
+		}
+	}
+	for i := 500; i < 1000; i++ {
+		if k := strconv.Itoa(i); !f.ContainsString(k) {
+			t.Fatalf("ContainsString(%s) = false after removing others", k)
+		}
+	}
+	if !f.RemoveString("twice") || !f.ContainsString("twice") || !f.RemoveString("twice") {
+		t.Error("an item added twice did not stay until removed twice")
+	}
+	if got := f.ApproxLen(); got != 500 {
+		t.Errorf("ApproxLen() = %d, want 500", got)
+	}
+
+	e := NewCountingBloomFilter(10, 0.01)
+	if e.RemoveString("missing") {
+		t.Error("RemoveString() of an empty filter = true")
+	}
+}
+
+func TestCountingBloomFilterSaturation(t *testing.T) {
+	f := NewCountingBloomFilter(10, 0.01)
+	for range 300 {
+		f.AddString("hot")
+	}
+	for range 300 {
+		f.RemoveString("hot")
+	}
+	if !f.ContainsString("hot") {
+		t.Error("ContainsString() = false after saturated counters were decremented")
+	}
+}
+
+func TestCountingBloomFilterUnion(t *testing.T) {
+	a, b := NewCountingBloomFilter(100, 0.01), NewCountingBloomFilter(100, 0.01)
+	a.AddString("x")
+	b.AddString("x")
+	b.AddString("y")
+	if err := a.Union(b); err != nil {
+		t.Fatalf("Union() returned %v", err)
+	}
+	a.RemoveString("x")
+	if !a.ContainsString("x") || !a.ContainsString("y") {
+		t.Error("Union() did not add up the counters")
+	}
+	if err := a.Union(NewCountingBloomFilter(100, 0.1)); !errors.Is(err, ErrBloomMismatch) {
+		t.Errorf("Union() of filters of other sizes returned %v, want ErrBloomMismatch", err)
+	}
+
+	plain := a.BloomFilter()
+	if !plain.ContainsString("x") || !plain.ContainsString("y") || plain.Bits() != a.Counters() {
+		t.Error("BloomFilter() lost items")
+	}
+}
+
+func TestCountingBloomFilterBinary(t *testing.T) {
+	f := NewCountingBloomFilter(100, 0.01)
+	f.AddString("a")
+	f.AddString("a")
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() returned %v", err)
+	}
+
+	var g CountingBloomFilter
+	if err := g.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() returned %v", err)
+	}
+	if !g.RemoveString("a") || !g.RemoveString("a") || g.ContainsString("a") {
+		t.Error("decoded filter lost the counts of a")
+	}
+	if !f.ContainsString("a") {
+		t.Error("removing from the decoded filter changed the original")
+	}
+
+	plain, _ := NewBloomFilter(100, 0.01).MarshalBinary()
+	for _, b := range [][]byte{data[:len(data)-1], plain} {
+		if err := g.UnmarshalBinary(b); !errors.Is(err, ErrBloomData) {
+			t.Errorf("UnmarshalBinary() returned %v, want ErrBloomData", err)
+		}
+	}
+}
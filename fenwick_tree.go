@@ -0,0 +1,126 @@
+package ds
+
+import "fmt"
+
+// Number is satisfied by the integer and floating-point types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// FenwickTree keeps the prefix sums of a sequence of numbers under point
+// updates, both in O(log n).
+type FenwickTree[T Number] struct {
+	// tree[i-1] holds the sum of the i&-i values ending at index i-1
+	tree []T
+}
+
+// NewFenwickTree returns a tree of n zeros.
+func NewFenwickTree[T Number](n int) *FenwickTree[T] {
+	return &FenwickTree[T]{tree: make([]T, max(n, 0))}
+}
+
+// NewFenwickTreeFrom builds a tree holding vs in O(n).
+func NewFenwickTreeFrom[T Number](vs ...T) *FenwickTree[T] {
+	tree := append([]T(nil), vs...)
+	for i := 1; i <= len(tree); i++ {
+		if j := i + i&-i; j <= len(tree) {
+			tree[j-1] += tree[i-1]
+		}
+	}
+	return &FenwickTree[T]{tree: tree}
+}
+
+func (f *FenwickTree[T]) Len() int {
+	return len(f.tree)
+}
+
+func (f *FenwickTree[T]) checkIndex(i int) error {
+	if i < 0 || i >= len(f.tree) {
+		return fmt.Errorf("%w [%d] with length %d", ErrListBounds, i, len(f.tree))
+	}
+	return nil
+}
+
+func (f *FenwickTree[T]) checkRange(lo, hi int) error {
+	if lo < 0 || hi < lo || hi > len(f.tree) {
+		return fmt.Errorf("%w [%d:%d] with length %d", ErrListBounds, lo, hi, len(f.tree))
+	}
+	return nil
+}
+
+// Add adds delta to the value at index i.
+func (f *FenwickTree[T]) Add(i int, delta T) error {
+	if err := f.checkIndex(i); err != nil {
+		return err
+	}
+	for i++; i <= len(f.tree); i += i & -i {
+		f.tree[i-1] += delta
+	}
+	return nil
+}
+
+// Set replaces the value at index i.
+func (f *FenwickTree[T]) Set(i int, v T) error {
+	old, err := f.Get(i)
+	if err != nil {
+		return err
+	}
+	return f.Add(i, v-old)
+}
+
+func (f *FenwickTree[T]) Get(i int) (T, error) {
+	if err := f.checkIndex(i); err != nil {
+		var zero T
+		return zero, err
+	}
+	return f.prefix(i+1) - f.prefix(i), nil
+}
+
+// prefix returns the sum of the first n values.
+func (f *FenwickTree[T]) prefix(n int) T {
+	var sum T
+	for ; n > 0; n -= n & -n {
+		sum += f.tree[n-1]
+	}
+	return sum
+}
+
+// PrefixSum returns the sum of the first n values.
+func (f *FenwickTree[T]) PrefixSum(n int) (T, error) {
+	if err := f.checkRange(0, n); err != nil {
+		var zero T
+		return zero, err
+	}
+	return f.prefix(n), nil
+}
+
+// RangeSum returns the sum of the values in [lo, hi).
+func (f *FenwickTree[T]) RangeSum(lo, hi int) (T, error) {
+	if err := f.checkRange(lo, hi); err != nil {
+		var zero T
+		return zero, err
+	}
+	return f.prefix(hi) - f.prefix(lo), nil
+}
+
+// LowerBound returns the smallest n whose PrefixSum(n) is at least target,
+// or Len()+1 if there is none. The values must not be negative.
+func (f *FenwickTree[T]) LowerBound(target T) int {
+	if target <= 0 {
+		return 0
+	}
+	pos := 0
+	step := 1
+	for step*2 <= len(f.tree) {
+		step *= 2
+	}
+	for ; step > 0; step /= 2 {
+		if next := pos + step; next <= len(f.tree) && f.tree[next-1] < target {
+			pos = next
+			target -= f.tree[next-1]
+		}
+	}
+	return pos + 1
+}
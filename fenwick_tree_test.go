@@ -0,0 +1,120 @@
+package ds
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestFenwickTree(t *testing.T) {
+	f := NewFenwickTreeFrom(3, 1, 4, 1, 5, 9, 2, 6)
+	prefix := []int{0, 3, 4, 8, 9, 14, 23, 25, 31}
+	for n, want := range prefix {
+		if got, err := f.PrefixSum(n); err != nil || got != want {
+			t.Errorf("PrefixSum(%d) = %d, %v, want %d", n, got, err, want)
+		}
+	}
+	if got, _ := f.RangeSum(2, 6); got != 19 {
+		t.Errorf("RangeSum(2, 6) = %d, want 19", got)
+	}
+
+	f.Add(4, 10)
+	f.Set(0, 0)
+	if got, _ := f.Get(4); got != 15 {
+		t.Errorf("Get(4) after Add(4, 10) = %d, want 15", got)
+	}
+	if got, _ := f.PrefixSum(8); got != 38 {
+		t.Errorf("PrefixSum(8) after updates = %d, want 38", got)
+	}
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"Add(-1)", f.Add(-1, 1)},
+		{"Set(8)", f.Set(8, 1)},
+		{"Get(8)", func() error { _, err := f.Get(8); return err }()},
+		{"PrefixSum(9)", func() error { _, err := f.PrefixSum(9); return err }()},
+		{"PrefixSum(-1)", func() error { _, err := f.PrefixSum(-1); return err }()},
+		{"RangeSum(5, 4)", func() error { _, err := f.RangeSum(5, 4); return err }()},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, ErrListBounds) {
+			t.Errorf("%s returned %v, want ErrListBounds", tt.name, tt.err)
+		}
+	}
+	if _, err := NewFenwickTree[float64](0).PrefixSum(0); err != nil {
+		t.Errorf("PrefixSum(0) of an empty tree returned %v", err)
+	}
+}
+
+func TestFenwickTreeLowerBound(t *testing.T) {
+	f := NewFenwickTreeFrom(2, 0, 3, 0, 0, 1)
+	tests := []struct {
+		target int
+		want   int
+	}{
+		{-1, 0}, {0, 0}, {1, 1}, {2, 1}, {3, 3}, {5, 3}, {6, 6}, {7, 7},
+	}
+	for _, tt := range tests {
+		if got := f.LowerBound(tt.target); got != tt.want {
+			t.Errorf("LowerBound(%d) = %d, want %d", tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestFenwickTreeModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(21, 22))
+	for _, n := range []int{1, 2, 7, 64, 100} {
+		model := make([]int, n)
+		for i := range model {
+			model[i] = r.IntN(100)
+		}
+		f := NewFenwickTreeFrom(model...)
+
+		for step := range 300 {
+			i := r.IntN(n)
+			if r.IntN(2) == 0 {
+				d := r.IntN(21) - 10
+				f.Add(i, d)
+				model[i] += d
+			} else {
+				v := r.IntN(100)
+				f.Set(i, v)
+				model[i] = v
+			}
+
+			lo := r.IntN(n + 1)
+			hi := lo + r.IntN(n-lo+1)
+			want := 0
+			for _, v := range model[lo:hi] {
+				want += v
+			}
+			if got, err := f.RangeSum(lo, hi); err != nil || got != want {
+				t.Fatalf("n %d step %d: RangeSum(%d, %d) = %d, %v, want %d", n, step, lo, hi, got, err, want)
+			}
+		}
+	}
+}
+
+const fenwickBenchSize = 1 << 16
+
+func BenchmarkFenwickTreeRangeSum(b *testing.B) {
+	f := NewFenwickTree[int](fenwickBenchSize)
+	for i := 0; b.Loop(); i++ {
+		f.Add(i%fenwickBenchSize, 1)
+		f.RangeSum(fenwickBenchSize/4, 3*fenwickBenchSize/4)
+	}
+}
+
+func BenchmarkSliceRangeSum(b *testing.B) {
+	s := make([]int, fenwickBenchSize)
+	for i := 0; b.Loop(); i++ {
+		s[i%fenwickBenchSize]++
+		sum := 0
+		for _, v := range s[fenwickBenchSize/4 : 3*fenwickBenchSize/4] {
+			sum += v
+		}
+		_ = sum
+	}
+}
@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"slices"
+
+	ds "github.com/5aradise/data-structs"
+)
+
+// ShortestPaths holds the shortest paths from one source vertex.
+type ShortestPaths[V comparable] struct {
+	g      *Graph[V]
+	source V
+	dist   []float64
+	prev   []int
+	reach  []bool
+}
+
+func (p *ShortestPaths[V]) Source() V {
+	return p.source
+}
+
+// Dist returns the length of the shortest path to v, or false if v is not
+// reachable.
+func (p *ShortestPaths[V]) Dist(v V) (float64, bool) {
+	i, ok := p.g.index[v]
+	if !ok || i >= len(p.reach) || !p.reach[i] {
+		return 0, false
+	}
+	return p.dist[i], true
+}
+
+// PathTo returns the vertices of the shortest path from the source to v,
+// both included, or false if v is not reachable.
+func (p *ShortestPaths[V]) PathTo(v V) ([]V, bool) {
+	i, ok := p.g.index[v]
+	if !ok || i >= len(p.reach) || !p.reach[i] {
+		return nil, false
+	}
+	var path []V
+	for ; i != -1; i = p.prev[i] {
+		path = append(path, p.g.vertices[i])
+	}
+	slices.Reverse(path)
+	return path, true
+}
+
+type dijkstraItem struct {
+	v    int
+	dist float64
+}
+
+// Dijkstra finds the shortest paths from source. The paths describe the
+// graph at the time of the call. It returns ErrNoVertex if source is not in
+// the graph and ErrNegativeWeight if any edge weighs less than zero.
+func (g *Graph[V]) Dijkstra(source V) (*ShortestPaths[V], error) {
+	s, ok := g.index[source]
+	if !ok {
+		return nil, ErrNoVertex
+	}
+	for _, e := range g.edges {
+		if e.Weight < 0 {
+			return nil, ErrNegativeWeight
+		}
+	}
+
+	n := len(g.vertices)
+	p := &ShortestPaths[V]{
+		g:      g,
+		source: source,
+		dist:   make([]float64, n),
+		prev:   make([]int, n),
+		reach:  make([]bool, n),
+	}
+	handles := make([]*ds.HeapHandle[dijkstraItem], n)
+	done := make([]bool, n)
+	h := ds.NewHeap(func(a, b dijkstraItem) bool { return a.dist < b.dist })
+
+	p.reach[s], p.prev[s] = true, -1
+	handles[s] = h.Push(dijkstraItem{s, 0})
+	for h.Len() > 0 {
+		it, _ := h.Pop()
+		done[it.v] = true
+		for _, e := range g.adj[it.v].Iter() {
+			j := g.index[e.To]
+			d := it.dist + e.Weight
+			switch {
+			case done[j]:
+			case !p.reach[j]:
+				p.reach[j] = true
+				p.dist[j], p.prev[j] = d, it.v
+				handles[j] = h.Push(dijkstraItem{j, d})
+			case d < p.dist[j]:
+				p.dist[j], p.prev[j] = d, it.v
+				h.Update(handles[j], dijkstraItem{j, d})
+			}
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestGraphDijkstra(t *testing.T) {
+	g := NewDirected[string]()
+	g.AddEdge("s", "a", 7)
+	g.AddEdge("s", "b", 2)
+	g.AddEdge("b", "a", 3)
+	g.AddEdge("a", "t", 1)
+	g.AddEdge("b", "t", 8)
+	g.AddEdge("t", "s", 1)
+	g.AddVertex("x")
+
+	p, err := g.Dijkstra("s")
+	if err != nil {
+		t.Fatalf("Dijkstra(s) returned %v", err)
+	}
+	tests := []struct {
+		to   string
+		dist float64
+		path []string
+	}{
+		{"s", 0, []string{"s"}},
+		{"b", 2, []string{"s", "b"}},
+		{"a", 5, []string{"s", "b", "a"}},
+		{"t", 6, []string{"s", "b", "a", "t"}},
+	}
+	for _, tt := range tests {
+		if d, ok := p.Dist(tt.to); !ok || d != tt.dist {
+			t.Errorf("Dist(%s) = %v, %v, want %v", tt.to, d, ok, tt.dist)
+		}
+		if path, ok := p.PathTo(tt.to); !ok || !slices.Equal(path, tt.path) {
+			t.Errorf("PathTo(%s) = %v, %v, want %v", tt.to, path, ok, tt.path)
+		}
+	}
+	for _, v := range []string{"x", "missing"} {
+		if _, ok := p.Dist(v); ok {
+			t.Errorf("Dist(%s) found a path", v)
+		}
+		if _, ok := p.PathTo(v); ok {
+			t.Errorf("PathTo(%s) found a path", v)
+		}
+	}
+
+	g.AddEdge("s", "y", 1)
+	if _, ok := p.Dist("y"); ok {
+		t.Error("Dist(y) of a vertex added after Dijkstra() found a path")
+	}
+	if _, err := g.Dijkstra("missing"); !errors.Is(err, ErrNoVertex) {
+		t.Errorf("Dijkstra(missing) returned %v, want ErrNoVertex", err)
+	}
+	g.AddEdge("x", "s", -1)
+	if _, err := g.Dijkstra("s"); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("Dijkstra(s) with a negative edge returned %v, want ErrNegativeWeight", err)
+	}
+}
+
+// TestGraphDijkstraModel compares Dijkstra with Floyd-Warshall on random
+// graphs.
+func TestGraphDijkstraModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	for round := range 50 {
+		n := 1 + r.IntN(12)
+		g := NewDirected[int]()
+		if round%2 == 1 {
+			g = NewUndirected[int]()
+		}
+		dist := make([][]float64, n)
+		for i := range n {
+			g.AddVertex(i)
+			dist[i] = make([]float64, n)
+			for j := range n {
+				dist[i][j] = math.Inf(1)
+			}
+			dist[i][i] = 0
+		}
+		for range r.IntN(3 * n) {
+			a, b, w := r.IntN(n), r.IntN(n), float64(r.IntN(10))
+			g.AddEdge(a, b, w)
+			dist[a][b] = min(dist[a][b], w)
+			if !g.Directed() {
+				dist[b][a] = min(dist[b][a], w)
+			}
+		}
+		for k := range n {
+			for i := range n {
+				for j := range n {
+					dist[i][j] = min(dist[i][j], dist[i][k]+dist[k][j])
+				}
+			}
+		}
+
+		for s := range n {
+			p, err := g.Dijkstra(s)
+			if err != nil {
+				t.Fatalf("round %d: Dijkstra(%d) returned %v", round, s, err)
+			}
+			for v := range n {
+				d, ok := p.Dist(v)
+				if want := dist[s][v]; ok != !math.IsInf(want, 1) || ok && d != want {
+					t.Fatalf("round %d: Dist(%d) from %d = %v, %v, want %v", round, v, s, d, ok, want)
+				}
+				path, _ := p.PathTo(v)
+				length := 0.0
+				for i := 1; i < len(path); i++ {
+					if !g.HasEdge(path[i-1], path[i]) {
+						t.Fatalf("round %d: PathTo(%d) from %d = %v uses a missing edge", round, v, s, path)
+					}
+					length += dist[path[i-1]][path[i]]
+				}
+				if ok && length != d {
+					t.Fatalf("round %d: PathTo(%d) from %d = %v of length %v, want %v", round, v, s, path, length, d)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkGraphDijkstra(b *testing.B) {
+	r := rand.New(rand.NewPCG(1, 2))
+	const n = 10000
+	g := NewDirected[int]()
+	for i := range n {
+		g.AddVertex(i)
+	}
+	for range 8 * n {
+		g.AddEdge(r.IntN(n), r.IntN(n), r.Float64())
+	}
+	for b.Loop() {
+		g.Dijkstra(0)
+	}
+}
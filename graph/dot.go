@@ -0,0 +1,43 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteDOT writes the graph in the Graphviz DOT language. Vertices are
+// labelled with their %v formatting and edges with their weights.
+func (g *Graph[V]) WriteDOT(w io.Writer) error {
+	kind, arrow := "graph", "--"
+	if g.directed {
+		kind, arrow = "digraph", "->"
+	}
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s {\n", kind)
+	for _, v := range g.vertices {
+		fmt.Fprintf(bw, "\t%s;\n", dotID(v))
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(bw, "\t%s %s %s [label=%q];\n",
+			dotID(e.From), arrow, dotID(e.To), strconv.FormatFloat(e.Weight, 'g', -1, 64))
+	}
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+// DOT returns the graph in the Graphviz DOT language, as written by
+// WriteDOT.
+func (g *Graph[V]) DOT() string {
+	var b strings.Builder
+	g.WriteDOT(&b)
+	return b.String()
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func dotID(v any) string {
+	return `"` + dotEscaper.Replace(fmt.Sprint(v)) + `"`
+}
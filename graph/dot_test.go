@@ -0,0 +1,34 @@
+package graph
+
+import "testing"
+
+func TestGraphDOT(t *testing.T) {
+	d := NewDirected[string]()
+	d.AddEdge("a", "b", 1.5)
+	d.AddEdge(`say "hi"`, "a", 2)
+	d.AddVertex("lonely")
+	want := `digraph {
+	"a";
+	"b";
+	"say \"hi\"";
+	"lonely";
+	"a" -> "b" [label="1.5"];
+	"say \"hi\"" -> "a" [label="2"];
+}
+`
+	if got := d.DOT(); got != want {
+		t.Errorf("DOT() =\n%s\nwant\n%s", got, want)
+	}
+
+	u := NewUndirected[int]()
+	u.AddEdge(1, 2, 3)
+	want = `graph {
+	"1";
+	"2";
+	"1" -- "2" [label="3"];
+}
+`
+	if got := u.DOT(); got != want {
+		t.Errorf("DOT() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,171 @@
+// Package graph implements weighted directed and undirected graphs whose
+// adjacency lists are ds lists. Vertices, edges and the results of the
+// algorithms follow the order in which vertices and edges were added.
+package graph
+
+import (
+	"errors"
+	"iter"
+	"slices"
+
+	ds "github.com/5aradise/data-structs"
+)
+
+var (
+	ErrNoVertex       = errors.New("graph: no such vertex")
+	ErrUndirected     = errors.New("graph: operation needs a directed graph")
+	ErrNegativeWeight = errors.New("graph: negative edge weight")
+)
+
+type Edge[V comparable] struct {
+	From   V
+	To     V
+	Weight float64
+}
+
+type Graph[V comparable] struct {
+	directed bool
+	index    map[V]int
+	vertices []V
+	// adj holds the edges leaving every vertex. An undirected edge is kept
+	// in the lists of both of its ends, with From set to the owning vertex.
+	adj   []ds.List[Edge[V]]
+	edges []Edge[V]
+}
+
+func NewDirected[V comparable]() *Graph[V] {
+	return &Graph[V]{directed: true, index: make(map[V]int)}
+}
+
+func NewUndirected[V comparable]() *Graph[V] {
+	return &Graph[V]{index: make(map[V]int)}
+}
+
+func (g *Graph[V]) Directed() bool {
+	return g.directed
+}
+
+// Order returns the number of vertices.
+func (g *Graph[V]) Order() int {
+	return len(g.vertices)
+}
+
+// Size returns the number of edges.
+func (g *Graph[V]) Size() int {
+	return len(g.edges)
+}
+
+// AddVertex reports whether v was not in the graph yet.
+func (g *Graph[V]) AddVertex(v V) bool {
+	if _, ok := g.index[v]; ok {
+		return false
+	}
+	g.index[v] = len(g.vertices)
+	g.vertices = append(g.vertices, v)
+	g.adj = append(g.adj, ds.NewCSLList[Edge[V]]())
+	return true
+}
+
+func (g *Graph[V]) HasVertex(v V) bool {
+	_, ok := g.index[v]
+	return ok
+}
+
+// AddEdge adds an edge, and the vertices it connects if they are new.
+// Parallel edges and loops are allowed.
+func (g *Graph[V]) AddEdge(from, to V, weight float64) {
+	g.AddVertex(from)
+	g.AddVertex(to)
+	e := Edge[V]{from, to, weight}
+	g.edges = append(g.edges, e)
+	g.adj[g.index[from]].Append(e)
+	if !g.directed && from != to {
+		g.adj[g.index[to]].Append(Edge[V]{to, from, weight})
+	}
+}
+
+func (g *Graph[V]) connects(e Edge[V], from, to V) bool {
+	return e.From == from && e.To == to || !g.directed && e.From == to && e.To == from
+}
+
+// RemoveEdge removes every edge from from to to and returns how many there
+// were.
+func (g *Graph[V]) RemoveEdge(from, to V) int {
+	n := len(g.edges)
+	g.edges = slices.DeleteFunc(g.edges, func(e Edge[V]) bool { return g.connects(e, from, to) })
+	if len(g.edges) == n {
+		return 0
+	}
+	removeEdges(g.adj[g.index[from]], to)
+	if !g.directed {
+		removeEdges(g.adj[g.index[to]], from)
+	}
+	return n - len(g.edges)
+}
+
+// removeEdges deletes the edges of l leading to to.
+func removeEdges[V comparable](l ds.List[Edge[V]], to V) {
+	for i := l.Length() - 1; i >= 0; i-- {
+		if e, _ := l.Get(i); e.To == to {
+			l.Delete(i)
+		}
+	}
+}
+
+func (g *Graph[V]) HasEdge(from, to V) bool {
+	i, ok := g.index[from]
+	if !ok {
+		return false
+	}
+	for _, e := range g.adj[i].Iter() {
+		if e.To == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Graph[V]) Vertices() iter.Seq[V] {
+	return slices.Values(g.vertices)
+}
+
+// Edges yields every edge once, as it was added.
+func (g *Graph[V]) Edges() iter.Seq[Edge[V]] {
+	return slices.Values(g.edges)
+}
+
+// OutEdges yields the edges leaving v. The edges of an undirected graph are
+// yielded with From set to v.
+func (g *Graph[V]) OutEdges(v V) iter.Seq[Edge[V]] {
+	return func(yield func(Edge[V]) bool) {
+		i, ok := g.index[v]
+		if !ok {
+			return
+		}
+		for _, e := range g.adj[i].Iter() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors yields the ends of the edges leaving v, once per edge.
+func (g *Graph[V]) Neighbors(v V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for e := range g.OutEdges(v) {
+			if !yield(e.To) {
+				return
+			}
+		}
+	}
+}
+
+// neighbors returns the indexes of the ends of the edges leaving vertex i.
+func (g *Graph[V]) neighbors(i int) []int {
+	ns := make([]int, 0, g.adj[i].Length())
+	for _, e := range g.adj[i].Iter() {
+		ns = append(ns, g.index[e.To])
+	}
+	return ns
+}
@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGraphEdges(t *testing.T) {
+	tests := []struct {
+		name     string
+		g        *Graph[string]
+		out      map[string][]string
+		hasEdges [][2]string
+		noEdges  [][2]string
+	}{
+		{
+			name:     "directed",
+			g:        NewDirected[string](),
+			out:      map[string][]string{"a": {"b", "c"}, "b": {"c"}, "c": {"c"}},
+			hasEdges: [][2]string{{"a", "b"}, {"a", "c"}, {"b", "c"}, {"c", "c"}},
+			noEdges:  [][2]string{{"b", "a"}, {"c", "a"}, {"x", "a"}},
+		},
+		{
+			name:     "undirected",
+			g:        NewUndirected[string](),
+			out:      map[string][]string{"a": {"b", "c"}, "b": {"a", "c"}, "c": {"a", "b", "c"}},
+			hasEdges: [][2]string{{"a", "b"}, {"b", "a"}, {"c", "a"}, {"c", "c"}},
+			noEdges:  [][2]string{{"x", "a"}, {"a", "x"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := tt.g
+			g.AddEdge("a", "b", 1)
+			g.AddEdge("a", "c", 2)
+			g.AddEdge("b", "c", 3)
+			g.AddEdge("c", "c", 4)
+			if g.AddVertex("a") || !g.AddVertex("d") {
+				t.Error("AddVertex(a), AddVertex(d), want false then true")
+			}
+
+			if got := slices.Collect(g.Vertices()); !slices.Equal(got, []string{"a", "b", "c", "d"}) {
+				t.Errorf("Vertices() = %v, want [a b c d]", got)
+			}
+			if g.Order() != 4 || g.Size() != 4 {
+				t.Errorf("Order(), Size() = %d, %d, want 4, 4", g.Order(), g.Size())
+			}
+			for v, want := range tt.out {
+				if got := slices.Collect(g.Neighbors(v)); !slices.Equal(got, want) {
+					t.Errorf("Neighbors(%s) = %v, want %v", v, got, want)
+				}
+				for e := range g.OutEdges(v) {
+					if e.From != v {
+						t.Errorf("OutEdges(%s) yielded %v", v, e)
+					}
+				}
+			}
+			for _, e := range tt.hasEdges {
+				if !g.HasEdge(e[0], e[1]) {
+					t.Errorf("HasEdge(%s, %s) = false, want true", e[0], e[1])
+				}
+			}
+			for _, e := range tt.noEdges {
+				if g.HasEdge(e[0], e[1]) {
+					t.Errorf("HasEdge(%s, %s) = true, want false", e[0], e[1])
+				}
+			}
+		})
+	}
+}
+
+func TestGraphRemoveEdge(t *testing.T) {
+	d := NewDirected[int]()
+	d.AddEdge(1, 2, 1)
+	d.AddEdge(1, 2, 5)
+	d.AddEdge(2, 1, 1)
+	d.AddEdge(1, 3, 1)
+	if got := d.RemoveEdge(1, 2); got != 2 {
+		t.Errorf("RemoveEdge(1, 2) = %d, want 2", got)
+	}
+	if got := d.RemoveEdge(1, 2); got != 0 {
+		t.Errorf("RemoveEdge(1, 2) again = %d, want 0", got)
+	}
+	if got := d.RemoveEdge(7, 8); got != 0 {
+		t.Errorf("RemoveEdge(7, 8) = %d, want 0", got)
+	}
+	if d.HasEdge(1, 2) || !d.HasEdge(2, 1) || !d.HasEdge(1, 3) || d.Size() != 2 {
+		t.Errorf("edges after RemoveEdge(1, 2) = %v", slices.Collect(d.Edges()))
+	}
+
+	u := NewUndirected[int]()
+	u.AddEdge(1, 2, 1)
+	u.AddEdge(2, 1, 2)
+	u.AddEdge(2, 3, 1)
+	u.AddEdge(3, 3, 1)
+	if got := u.RemoveEdge(1, 2); got != 2 {
+		t.Errorf("RemoveEdge(1, 2) = %d, want 2", got)
+	}
+	if got := u.RemoveEdge(3, 3); got != 1 {
+		t.Errorf("RemoveEdge(3, 3) = %d, want 1", got)
+	}
+	if u.HasEdge(2, 1) || !u.HasEdge(3, 2) || u.HasEdge(3, 3) || u.Size() != 1 {
+		t.Errorf("edges after RemoveEdge() = %v", slices.Collect(u.Edges()))
+	}
+	if got := slices.Collect(u.Neighbors(2)); !slices.Equal(got, []int{3}) {
+		t.Errorf("Neighbors(2) = %v, want [3]", got)
+	}
+}
@@ -0,0 +1,64 @@
+package graph
+
+import "slices"
+
+// SCC returns the strongly connected components of the graph, found with
+// Tarjan's algorithm. The vertices of every component and the components
+// themselves, by their first vertex, are in the order the vertices were
+// added. For an undirected graph the components are the connected ones.
+func (g *Graph[V]) SCC() [][]V {
+	n := len(g.vertices)
+	index := make([]int, n) // 0 until visited, then the 1-based visit order
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	var stack []int
+	var comps [][]int
+	next := 1
+
+	var visit func(i int)
+	visit = func(i int) {
+		index[i], low[i] = next, next
+		next++
+		stack = append(stack, i)
+		onStack[i] = true
+		for _, j := range g.neighbors(i) {
+			switch {
+			case index[j] == 0:
+				visit(j)
+				low[i] = min(low[i], low[j])
+			case onStack[j]:
+				low[i] = min(low[i], index[j])
+			}
+		}
+		if low[i] != index[i] {
+			return
+		}
+		k := len(stack) - 1
+		for stack[k] != i {
+			k--
+		}
+		comp := slices.Clone(stack[k:])
+		for _, j := range comp {
+			onStack[j] = false
+		}
+		stack = stack[:k]
+		slices.Sort(comp)
+		comps = append(comps, comp)
+	}
+
+	for i := range n {
+		if index[i] == 0 {
+			visit(i)
+		}
+	}
+
+	slices.SortFunc(comps, func(a, b []int) int { return a[0] - b[0] })
+	res := make([][]V, len(comps))
+	for c, comp := range comps {
+		res[c] = make([]V, len(comp))
+		for k, i := range comp {
+			res[c][k] = g.vertices[i]
+		}
+	}
+	return res
+}
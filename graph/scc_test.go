@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGraphSCC(t *testing.T) {
+	tests := []struct {
+		name  string
+		g     *Graph[int]
+		edges [][2]int
+		want  [][]int
+	}{
+		{
+			name: "empty",
+			g:    NewDirected[int](),
+			want: [][]int{},
+		},
+		{
+			name:  "dag",
+			g:     NewDirected[int](),
+			edges: [][2]int{{1, 2}, {2, 3}, {1, 3}},
+			want:  [][]int{{1}, {2}, {3}},
+		},
+		{
+			name:  "cycles",
+			g:     NewDirected[int](),
+			edges: [][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}, {5, 6}, {6, 4}, {7, 6}, {8, 8}},
+			want:  [][]int{{1, 2, 3}, {4, 5, 6}, {7}, {8}},
+		},
+		{
+			name:  "found late",
+			g:     NewDirected[int](),
+			edges: [][2]int{{5, 1}, {1, 2}, {2, 5}, {3, 4}, {4, 3}, {2, 3}},
+			want:  [][]int{{5, 1, 2}, {3, 4}},
+		},
+		{
+			name:  "undirected",
+			g:     NewUndirected[int](),
+			edges: [][2]int{{1, 2}, {3, 4}, {2, 5}},
+			want:  [][]int{{1, 2, 5}, {3, 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, e := range tt.edges {
+				tt.g.AddEdge(e[0], e[1], 1)
+			}
+			if got := tt.g.SCC(); !slices.EqualFunc(got, tt.want, slices.Equal) {
+				t.Errorf("SCC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	ds "github.com/5aradise/data-structs"
+)
+
+// BFS yields the vertices reachable from start in breadth-first order.
+func (g *Graph[V]) BFS(start V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		s, ok := g.index[start]
+		if !ok {
+			return
+		}
+		seen := make([]bool, len(g.vertices))
+		seen[s] = true
+		q := ds.NewQueue(s)
+		for q.Len() > 0 {
+			i, _ := q.Pop()
+			if !yield(g.vertices[i]) {
+				return
+			}
+			for _, j := range g.neighbors(i) {
+				if !seen[j] {
+					seen[j] = true
+					q.Push(j)
+				}
+			}
+		}
+	}
+}
+
+// DFS yields the vertices reachable from start in depth-first preorder,
+// visiting the edges of every vertex in the order they were added.
+func (g *Graph[V]) DFS(start V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		s, ok := g.index[start]
+		if !ok {
+			return
+		}
+		seen := make([]bool, len(g.vertices))
+		seen[s] = true
+		if !yield(start) {
+			return
+		}
+		// every entry holds the neighbors of a vertex on the path that are
+		// still to be tried
+		stack := [][]int{g.neighbors(s)}
+		for len(stack) > 0 {
+			top := len(stack) - 1
+			if len(stack[top]) == 0 {
+				stack = stack[:top]
+				continue
+			}
+			j := stack[top][0]
+			stack[top] = stack[top][1:]
+			if seen[j] {
+				continue
+			}
+			seen[j] = true
+			if !yield(g.vertices[j]) {
+				return
+			}
+			stack = append(stack, g.neighbors(j))
+		}
+	}
+}
+
+// CycleError is returned by TopoSort for a graph with a cycle. Cycle lists
+// the vertices of one cycle in edge order; its last vertex has an edge to
+// the first.
+type CycleError[V comparable] struct {
+	Cycle []V
+}
+
+func (e *CycleError[V]) Error() string {
+	var b strings.Builder
+	b.WriteString("graph: cycle ")
+	for _, v := range e.Cycle {
+		fmt.Fprintf(&b, "%v -> ", v)
+	}
+	fmt.Fprintf(&b, "%v", e.Cycle[0])
+	return b.String()
+}
+
+// TopoSort orders the vertices of a directed graph so that every edge leads
+// forward. Of the vertices that are ready, the one added first comes first.
+// It returns a *CycleError if the graph has a cycle and ErrUndirected for an
+// undirected graph.
+func (g *Graph[V]) TopoSort() ([]V, error) {
+	if !g.directed {
+		return nil, ErrUndirected
+	}
+	in := make([]int, len(g.vertices))
+	for _, e := range g.edges {
+		in[g.index[e.To]]++
+	}
+	q := ds.NewQueue[int]()
+	for i, d := range in {
+		if d == 0 {
+			q.Push(i)
+		}
+	}
+	order := make([]V, 0, len(g.vertices))
+	for q.Len() > 0 {
+		i, _ := q.Pop()
+		order = append(order, g.vertices[i])
+		for _, j := range g.neighbors(i) {
+			if in[j]--; in[j] == 0 {
+				q.Push(j)
+			}
+		}
+	}
+	if len(order) < len(g.vertices) {
+		return nil, &CycleError[V]{g.findCycle()}
+	}
+	return order, nil
+}
+
+// findCycle returns the first cycle met by a depth-first search over the
+// whole graph, or nil if there is none.
+func (g *Graph[V]) findCycle() []V {
+	const (
+		white = iota
+		grey
+		black
+	)
+	color := make([]int, len(g.vertices))
+	parent := make([]int, len(g.vertices))
+
+	var visit func(i int) []V
+	visit = func(i int) []V {
+		color[i] = grey
+		for _, j := range g.neighbors(i) {
+			switch color[j] {
+			case white:
+				parent[j] = i
+				if c := visit(j); c != nil {
+					return c
+				}
+			case grey:
+				cycle := []V{g.vertices[i]}
+				for k := i; k != j; {
+					k = parent[k]
+					cycle = append(cycle, g.vertices[k])
+				}
+				slices.Reverse(cycle)
+				return cycle
+			}
+		}
+		color[i] = black
+		return nil
+	}
+
+	for i := range g.vertices {
+		if color[i] == white {
+			if c := visit(i); c != nil {
+				return c
+			}
+		}
+	}
+	return nil
+}
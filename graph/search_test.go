@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// newTree returns the directed graph
+//
+//	a -> b -> d
+//	a -> c -> d -> e
+//	c -> f
+func newTree() *Graph[string] {
+	g := NewDirected[string]()
+	for _, e := range [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}, {"c", "f"}, {"d", "e"}} {
+		g.AddEdge(e[0], e[1], 1)
+	}
+	return g
+}
+
+func TestGraphSearch(t *testing.T) {
+	g := newTree()
+	g.AddVertex("x")
+	tests := []struct {
+		start    string
+		bfs, dfs []string
+	}{
+		{"a", []string{"a", "b", "c", "d", "f", "e"}, []string{"a", "b", "d", "e", "c", "f"}},
+		{"c", []string{"c", "d", "f", "e"}, []string{"c", "d", "e", "f"}},
+		{"x", []string{"x"}, []string{"x"}},
+		{"missing", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.start, func(t *testing.T) {
+			if got := slices.Collect(g.BFS(tt.start)); !slices.Equal(got, tt.bfs) {
+				t.Errorf("BFS(%s) = %v, want %v", tt.start, got, tt.bfs)
+			}
+			if got := slices.Collect(g.DFS(tt.start)); !slices.Equal(got, tt.dfs) {
+				t.Errorf("DFS(%s) = %v, want %v", tt.start, got, tt.dfs)
+			}
+		})
+	}
+
+	t.Run("cycle", func(t *testing.T) {
+		u := NewUndirected[int]()
+		u.AddEdge(1, 2, 1)
+		u.AddEdge(2, 3, 1)
+		u.AddEdge(3, 1, 1)
+		u.AddEdge(3, 4, 1)
+		if got := slices.Collect(u.BFS(1)); !slices.Equal(got, []int{1, 2, 3, 4}) {
+			t.Errorf("BFS(1) = %v, want [1 2 3 4]", got)
+		}
+		if got := slices.Collect(u.DFS(1)); !slices.Equal(got, []int{1, 2, 3, 4}) {
+			t.Errorf("DFS(1) = %v, want [1 2 3 4]", got)
+		}
+	})
+
+	t.Run("break", func(t *testing.T) {
+		var got []string
+		for v := range g.DFS("a") {
+			if got = append(got, v); len(got) == 3 {
+				break
+			}
+		}
+		if !slices.Equal(got, []string{"a", "b", "d"}) {
+			t.Errorf("first 3 of DFS(a) = %v, want [a b d]", got)
+		}
+	})
+}
+
+func TestGraphTopoSort(t *testing.T) {
+	g := newTree()
+	g.AddVertex("x")
+	got, err := g.TopoSort()
+	if want := []string{"a", "x", "b", "c", "d", "f", "e"}; err != nil || !slices.Equal(got, want) {
+		t.Errorf("TopoSort() = %v, %v, want %v", got, err, want)
+	}
+
+	g.AddEdge("e", "c", 1)
+	_, err = g.TopoSort()
+	var cycle *CycleError[string]
+	if !errors.As(err, &cycle) {
+		t.Fatalf("TopoSort() of a graph with a cycle returned %v", err)
+	}
+	if want := []string{"d", "e", "c"}; !slices.Equal(cycle.Cycle, want) {
+		t.Errorf("cycle = %v, want %v", cycle.Cycle, want)
+	}
+	if want := "graph: cycle d -> e -> c -> d"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	loop := NewDirected[int]()
+	loop.AddEdge(1, 1, 1)
+	var loopCycle *CycleError[int]
+	if _, err := loop.TopoSort(); !errors.As(err, &loopCycle) || !slices.Equal(loopCycle.Cycle, []int{1}) {
+		t.Errorf("TopoSort() of a loop returned %v, want the cycle [1]", err)
+	}
+
+	if _, err := NewUndirected[int]().TopoSort(); !errors.Is(err, ErrUndirected) {
+		t.Errorf("TopoSort() of an undirected graph returned %v, want ErrUndirected", err)
+	}
+}
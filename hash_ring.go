@@ -0,0 +1,189 @@
+package ds
+
+import (
+	"fmt"
+	"hash/fnv"
+	"iter"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+type HashFunc func(data []byte) uint64
+
+func FNV1a64(data []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(data)
+	return h.Sum64()
+}
+
+// fnv1a64Mix is the default hash of a HashRing. Plain FNV-1a changes little
+// in the high bits for keys that differ only in their last bytes, which
+// crowds the points of a node together, so the sum is passed through the
+// finalizer of MurmurHash3.
+func fnv1a64Mix(data []byte) uint64 {
+	return fmix64(FNV1a64(data))
+}
+
+// fmix64 is the 64-bit finalizer of MurmurHash3.
+func fmix64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+type ringPoint[N comparable] struct {
+	hash uint64
+	node N
+}
+
+type HashRingOption[N comparable] func(*HashRing[N])
+
+// WithHash replaces the default FNV-1a based hash as the hash of nodes and keys.
+func WithHash[N comparable](hash HashFunc) HashRingOption[N] {
+	return func(r *HashRing[N]) {
+		r.hash = hash
+	}
+}
+
+// WithNodeName sets how a node is named when its virtual nodes are hashed.
+// By default nodes are formatted with fmt.Sprint.
+func WithNodeName[N comparable](name func(N) string) HashRingOption[N] {
+	return func(r *HashRing[N]) {
+		r.name = name
+	}
+}
+
+// HashRing maps keys to nodes by consistent hashing. Every node is placed on
+// the ring replicas times, and a key belongs to the first node clockwise from
+// its hash.
+type HashRing[N comparable] struct {
+	replicas int
+	hash     HashFunc
+	name     func(N) string
+	points   []ringPoint[N]
+	nodes    []N
+}
+
+func NewHashRing[N comparable](replicas int, opts ...HashRingOption[N]) *HashRing[N] {
+	r := &HashRing[N]{
+		replicas: max(replicas, 1),
+		hash:     fnv1a64Mix,
+		name:     func(n N) string { return fmt.Sprint(n) },
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func (r *HashRing[N]) Len() int {
+	return len(r.nodes)
+}
+
+// Nodes returns the nodes in the order they were added.
+func (r *HashRing[N]) Nodes() []N {
+	return slices.Clone(r.nodes)
+}
+
+func (r *HashRing[N]) Contains(n N) bool {
+	return slices.Contains(r.nodes, n)
+}
+
+func (r *HashRing[N]) Clone() *HashRing[N] {
+	c := *r
+	c.points = slices.Clone(r.points)
+	c.nodes = slices.Clone(r.nodes)
+	return &c
+}
+
+// Add places n on the ring and reports whether it was not there yet.
+func (r *HashRing[N]) Add(n N) bool {
+	if r.Contains(n) {
+		return false
+	}
+	r.nodes = append(r.nodes, n)
+	name := r.name(n)
+	for i := range r.replicas {
+		h := r.hash([]byte(name + "#" + strconv.Itoa(i)))
+		// points with equal hashes keep the order their nodes were added in
+		j := sort.Search(len(r.points), func(j int) bool { return r.points[j].hash > h })
+		r.points = slices.Insert(r.points, j, ringPoint[N]{h, n})
+	}
+	return true
+}
+
+func (r *HashRing[N]) Remove(n N) bool {
+	i := slices.Index(r.nodes, n)
+	if i == -1 {
+		return false
+	}
+	r.nodes = slices.Delete(r.nodes, i, i+1)
+	r.points = slices.DeleteFunc(r.points, func(p ringPoint[N]) bool { return p.node == n })
+	return true
+}
+
+// successor returns the index of the first point clockwise from the hash of
+// key.
+func (r *HashRing[N]) successor(key string) int {
+	h := r.hash([]byte(key))
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i].hash >= h })
+	if i == len(r.points) {
+		return 0
+	}
+	return i
+}
+
+// Locate returns the node owning key.
+func (r *HashRing[N]) Locate(key string) (N, bool) {
+	if len(r.points) == 0 {
+		var zero N
+		return zero, false
+	}
+	return r.points[r.successor(key)].node, true
+}
+
+// LocateN returns up to n distinct nodes for key, the owner first and then
+// the replicas in clockwise order.
+func (r *HashRing[N]) LocateN(key string, n int) []N {
+	n = min(n, len(r.nodes))
+	if n <= 0 {
+		return nil
+	}
+	found := make([]N, 0, n)
+	start := r.successor(key)
+	for i := range len(r.points) {
+		node := r.points[(start+i)%len(r.points)].node
+		if !slices.Contains(found, node) {
+			found = append(found, node)
+			if len(found) == n {
+				break
+			}
+		}
+	}
+	return found
+}
+
+type KeyMove[N comparable] struct {
+	Key  string
+	From N
+	To   N
+}
+
+// KeyMoves reports the keys whose owner differs between two rings, e.g. a
+// ring and its clone after a membership change. Keys without an owner in
+// either ring are skipped.
+func KeyMoves[N comparable](from, to *HashRing[N], keys iter.Seq[string]) []KeyMove[N] {
+	var moves []KeyMove[N]
+	for k := range keys {
+		a, okA := from.Locate(k)
+		b, okB := to.Locate(k)
+		if okA && okB && a != b {
+			moves = append(moves, KeyMove[N]{k, a, b})
+		}
+	}
+	return moves
+}
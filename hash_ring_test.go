@@ -0,0 +1,198 @@
+package ds
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// numberHash hashes "12" and "12#0" to 12, so tests can place nodes and keys
+// on the ring by hand.
+func numberHash(data []byte) uint64 {
+	s, _, _ := strings.Cut(string(data), "#")
+	n, _ := strconv.ParseUint(s, 10, 64)
+	return n
+}
+
+func TestHashRingLocate(t *testing.T) {
+	tests := []struct {
+		name   string
+		nodes  []int
+		remove []int
+		key    string
+		want   int
+		wantOk bool
+		wantN  []int
+	}{
+		{
+			name: "empty ring",
+			key:  "5",
+		},
+		{
+			name:   "exact point",
+			nodes:  []int{10, 20, 30},
+			key:    "20",
+			want:   20,
+			wantOk: true,
+			wantN:  []int{20, 30, 10},
+		},
+		{
+			name:   "between points",
+			nodes:  []int{10, 20, 30},
+			key:    "11",
+			want:   20,
+			wantOk: true,
+			wantN:  []int{20, 30, 10},
+		},
+		{
+			name:   "wraps around",
+			nodes:  []int{30, 10, 20},
+			key:    "31",
+			want:   10,
+			wantOk: true,
+			wantN:  []int{10, 20, 30},
+		},
+		{
+			name:   "removed node passes keys on",
+			nodes:  []int{10, 20, 30},
+			remove: []int{20, 40},
+			key:    "15",
+			want:   30,
+			wantOk: true,
+			wantN:  []int{30, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewHashRing(1, WithHash[int](numberHash))
+			for _, n := range tt.nodes {
+				r.Add(n)
+			}
+			for _, n := range tt.remove {
+				r.Remove(n)
+			}
+
+			if got, ok := r.Locate(tt.key); got != tt.want || ok != tt.wantOk {
+				t.Errorf("Locate(%q) = (%v, %v), want (%v, %v)", tt.key, got, ok, tt.want, tt.wantOk)
+			}
+			if got := r.LocateN(tt.key, 5); !slices.Equal(got, tt.wantN) {
+				t.Errorf("LocateN(%q, 5) = %v, want %v", tt.key, got, tt.wantN)
+			}
+		})
+	}
+}
+
+func TestFNV1a64(t *testing.T) {
+	tests := []struct {
+		in   string
+		want uint64
+	}{
+		{"", 0xcbf29ce484222325},
+		{"a", 0xaf63dc4c8601ec8c},
+		{"foobar", 0x85944171f73967e8},
+	}
+	for _, tt := range tests {
+		if got := FNV1a64([]byte(tt.in)); got != tt.want {
+			t.Errorf("FNV1a64(%q) = %#x, want %#x", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestHashRingMembership(t *testing.T) {
+	r := NewHashRing[string](3)
+	if !r.Add("a") || !r.Add("b") || r.Add("a") {
+		t.Error("Add() results, want true, true, false")
+	}
+	if got := r.Nodes(); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("Nodes() = %v, want [a b]", got)
+	}
+	if len(r.points) != 6 {
+		t.Errorf("ring has %d points, want 6", len(r.points))
+	}
+	if !r.Remove("a") || r.Remove("a") {
+		t.Error("Remove() results, want true, false")
+	}
+	if r.Len() != 1 || r.Contains("a") || len(r.points) != 3 {
+		t.Errorf("ring after Remove(a) has nodes %v and %d points", r.Nodes(), len(r.points))
+	}
+	if got := r.LocateN("k", 0); got != nil {
+		t.Errorf("LocateN(k, 0) = %v, want nil", got)
+	}
+
+	named := NewHashRing(1, WithHash[int](numberHash), WithNodeName(func(n int) string {
+		return strconv.Itoa(n * 10)
+	}))
+	named.Add(1)
+	named.Add(2)
+	if got, _ := named.Locate("15"); got != 2 {
+		t.Errorf("Locate(15) with named nodes = %v, want 2", got)
+	}
+}
+
+func TestHashRingKeyMoves(t *testing.T) {
+	keys := func(yield func(string) bool) {
+		for i := range 10000 {
+			if !yield("key-" + strconv.Itoa(i)) {
+				return
+			}
+		}
+	}
+
+	r := NewHashRing[string](100)
+	for _, n := range []string{"node-a", "node-b", "node-c"} {
+		r.Add(n)
+	}
+
+	owned := map[string]int{}
+	for k := range keys {
+		n, _ := r.Locate(k)
+		owned[n]++
+	}
+	for n, count := range owned {
+		if count < 2000 || count > 4700 {
+			t.Errorf("%s owns %d of 10000 keys, want a roughly even share", n, count)
+		}
+	}
+
+	grown := r.Clone()
+	grown.Add("node-d")
+	moves := KeyMoves(r, grown, keys)
+	if len(moves) < 1000 || len(moves) > 4000 {
+		t.Errorf("adding a fourth node moved %d of 10000 keys, want about a quarter", len(moves))
+	}
+	for _, m := range moves {
+		if m.To != "node-d" {
+			t.Fatalf("key %s moved from %s to %s, want only moves to node-d", m.Key, m.From, m.To)
+		}
+	}
+	if r.Contains("node-d") {
+		t.Error("Add() on a clone changed the original ring")
+	}
+
+	shrunk := r.Clone()
+	shrunk.Remove("node-b")
+	for _, m := range KeyMoves(r, shrunk, keys) {
+		if m.From != "node-b" {
+			t.Fatalf("key %s moved from %s to %s, want only moves from node-b", m.Key, m.From, m.To)
+		}
+	}
+	if got := len(KeyMoves(r, shrunk, keys)); got != owned["node-b"] {
+		t.Errorf("removing node-b moved %d keys, want the %d it owned", got, owned["node-b"])
+	}
+}
+
+func BenchmarkHashRingLocate(b *testing.B) {
+	r := NewHashRing[int](100)
+	for n := range 50 {
+		r.Add(n)
+	}
+	keys := make([]string, 1024)
+	for i := range keys {
+		keys[i] = "key-" + strconv.Itoa(i)
+	}
+	for i := 0; b.Loop(); i++ {
+		r.Locate(keys[i%len(keys)])
+	}
+}
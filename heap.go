@@ -0,0 +1,183 @@
+package ds
+
+import (
+	"iter"
+	"slices"
+)
+
+// HeapHandle refers to an element pushed to a Heap and stays valid until the
+// element is popped or removed.
+type HeapHandle[E any] struct {
+	value E
+	index int
+	heap  *Heap[E]
+}
+
+func (h *HeapHandle[E]) Value() E {
+	return h.value
+}
+
+// Heap is a binary min-heap ordered by less.
+type Heap[E any] struct {
+	items []*HeapHandle[E]
+	less  func(a, b E) bool
+}
+
+// PriorityQueue pops elements in the order given by less.
+type PriorityQueue[E any] = Heap[E]
+
+// NewHeap heapifies vs in O(n).
+func NewHeap[E any](less func(a, b E) bool, vs ...E) *Heap[E] {
+	h := &Heap[E]{items: make([]*HeapHandle[E], len(vs)), less: less}
+	for i, v := range vs {
+		h.items[i] = &HeapHandle[E]{value: v, index: i, heap: h}
+	}
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+func (h *Heap[E]) Len() int {
+	return len(h.items)
+}
+
+func (h *Heap[E]) Push(v E) *HeapHandle[E] {
+	item := &HeapHandle[E]{value: v, index: len(h.items), heap: h}
+	h.items = append(h.items, item)
+	h.up(item.index)
+	return item
+}
+
+func (h *Heap[E]) Pop() (E, bool) {
+	if len(h.items) == 0 {
+		var zero E
+		return zero, false
+	}
+	return h.removeAt(0), true
+}
+
+func (h *Heap[E]) Peek() (E, bool) {
+	if len(h.items) == 0 {
+		var zero E
+		return zero, false
+	}
+	return h.items[0].value, true
+}
+
+// Fix restores the heap order after the value behind handle has changed.
+func (h *Heap[E]) Fix(handle *HeapHandle[E]) bool {
+	if !h.owns(handle) {
+		return false
+	}
+	if !h.down(handle.index) {
+		h.up(handle.index)
+	}
+	return true
+}
+
+func (h *Heap[E]) Update(handle *HeapHandle[E], v E) bool {
+	if !h.owns(handle) {
+		return false
+	}
+	handle.value = v
+	return h.Fix(handle)
+}
+
+func (h *Heap[E]) Remove(handle *HeapHandle[E]) (E, bool) {
+	if !h.owns(handle) {
+		var zero E
+		return zero, false
+	}
+	return h.removeAt(handle.index), true
+}
+
+func (h *Heap[E]) Clear() {
+	for _, item := range h.items {
+		item.heap = nil
+	}
+	h.items = nil
+}
+
+// Iter yields the elements in the heap order, which is not sorted.
+func (h *Heap[E]) Iter() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, item := range h.items {
+			if !yield(item.value) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted yields the elements in ascending order without modifying the heap.
+func (h *Heap[E]) Sorted() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		c := NewHeap(h.less, slices.Collect(h.Iter())...)
+		for v, ok := c.Pop(); ok; v, ok = c.Pop() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (h *Heap[E]) owns(handle *HeapHandle[E]) bool {
+	return handle != nil && handle.heap == h
+}
+
+func (h *Heap[E]) removeAt(i int) E {
+	item := h.items[i]
+	n := len(h.items) - 1
+	if i != n {
+		h.swap(i, n)
+	}
+	h.items[n] = nil
+	h.items = h.items[:n]
+	if i != n {
+		if !h.down(i) {
+			h.up(i)
+		}
+	}
+	item.heap = nil
+	item.index = -1
+	return item.value
+}
+
+func (h *Heap[E]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *Heap[E]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i].value, h.items[parent].value) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// down reports whether the element at i has moved.
+func (h *Heap[E]) down(i int) bool {
+	start := i
+	n := len(h.items)
+	for {
+		child := 2*i + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && h.less(h.items[right].value, h.items[child].value) {
+			child = right
+		}
+		if !h.less(h.items[child].value, h.items[i].value) {
+			break
+		}
+		h.swap(i, child)
+		i = child
+	}
+	return i > start
+}
@@ -0,0 +1,218 @@
+package ds
+
+import (
+	"slices"
+	"testing"
+)
+
+func intLess(a, b int) bool {
+	return a < b
+}
+
+func TestHeapPushPop(t *testing.T) {
+	tests := []struct {
+		name     string
+		init     []int
+		push     []int
+		less     func(a, b int) bool
+		wantPeek int
+		wantPops []int
+	}{
+		{
+			name:     "empty",
+			init:     []int{},
+			less:     intLess,
+			wantPops: []int{},
+		},
+		{
+			name:     "heapify",
+			init:     []int{5, 3, 8, 1, 9, 2},
+			less:     intLess,
+			wantPeek: 1,
+			wantPops: []int{1, 2, 3, 5, 8, 9},
+		},
+		{
+			name:     "push",
+			init:     []int{},
+			push:     []int{4, 1, 7, 3},
+			less:     intLess,
+			wantPeek: 1,
+			wantPops: []int{1, 3, 4, 7},
+		},
+		{
+			name:     "heapify and push with duplicates",
+			init:     []int{2, 2, 5},
+			push:     []int{1, 5, 2},
+			less:     intLess,
+			wantPeek: 1,
+			wantPops: []int{1, 2, 2, 2, 5, 5},
+		},
+		{
+			name:     "max heap",
+			init:     []int{5, 3, 8},
+			push:     []int{10, 1},
+			less:     func(a, b int) bool { return a > b },
+			wantPeek: 10,
+			wantPops: []int{10, 8, 5, 3, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHeap(tt.less, tt.init...)
+			for _, v := range tt.push {
+				h.Push(v)
+			}
+
+			if got, _ := h.Peek(); got != tt.wantPeek {
+				t.Errorf("Peek() = %v, want %v", got, tt.wantPeek)
+			}
+
+			sorted := slices.Collect(h.Sorted())
+			if !slices.Equal(sorted, tt.wantPops) {
+				t.Errorf("Sorted() produced %v, want %v", sorted, tt.wantPops)
+			}
+
+			arbitrary := slices.Collect(h.Iter())
+			slices.SortFunc(arbitrary, func(a, b int) int {
+				switch {
+				case tt.less(a, b):
+					return -1
+				case tt.less(b, a):
+					return 1
+				}
+				return 0
+			})
+			if !slices.Equal(arbitrary, tt.wantPops) {
+				t.Errorf("sorted Iter() produced %v, want %v", arbitrary, tt.wantPops)
+			}
+
+			gotPops := []int{}
+			for v, ok := h.Pop(); ok; v, ok = h.Pop() {
+				gotPops = append(gotPops, v)
+			}
+			if !slices.Equal(gotPops, tt.wantPops) {
+				t.Errorf("Pop() sequence = %v, want %v", gotPops, tt.wantPops)
+			}
+
+			if _, ok := h.Peek(); ok {
+				t.Error("Peek() on drained heap returned ok")
+			}
+		})
+	}
+}
+
+func TestHeapHandles(t *testing.T) {
+	tests := []struct {
+		name     string
+		init     []int
+		op       func(h *Heap[int], handles []*HeapHandle[int]) bool
+		wantOk   bool
+		wantPops []int
+	}{
+		{
+			name: "update to new minimum",
+			init: []int{5, 6, 7},
+			op: func(h *Heap[int], hs []*HeapHandle[int]) bool {
+				return h.Update(hs[2], 1)
+			},
+			wantOk:   true,
+			wantPops: []int{1, 5, 6},
+		},
+		{
+			name: "update to new maximum",
+			init: []int{5, 6, 7},
+			op: func(h *Heap[int], hs []*HeapHandle[int]) bool {
+				return h.Update(hs[0], 10)
+			},
+			wantOk:   true,
+			wantPops: []int{6, 7, 10},
+		},
+		{
+			name: "remove middle",
+			init: []int{5, 6, 7, 8},
+			op: func(h *Heap[int], hs []*HeapHandle[int]) bool {
+				v, ok := h.Remove(hs[1])
+				return ok && v == 6
+			},
+			wantOk:   true,
+			wantPops: []int{5, 7, 8},
+		},
+		{
+			name: "remove twice",
+			init: []int{5, 6},
+			op: func(h *Heap[int], hs []*HeapHandle[int]) bool {
+				h.Remove(hs[0])
+				_, ok := h.Remove(hs[0])
+				return ok
+			},
+			wantOk:   false,
+			wantPops: []int{6},
+		},
+		{
+			name: "update popped handle",
+			init: []int{5, 6},
+			op: func(h *Heap[int], hs []*HeapHandle[int]) bool {
+				h.Pop()
+				return h.Update(hs[0], 1)
+			},
+			wantOk:   false,
+			wantPops: []int{6},
+		},
+		{
+			name: "handle of another heap",
+			init: []int{5, 6},
+			op: func(h *Heap[int], hs []*HeapHandle[int]) bool {
+				other := NewHeap(intLess)
+				return other.Fix(hs[0])
+			},
+			wantOk:   false,
+			wantPops: []int{5, 6},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHeap(intLess)
+			var handles []*HeapHandle[int]
+			for _, v := range tt.init {
+				handles = append(handles, h.Push(v))
+			}
+
+			if got := tt.op(h, handles); got != tt.wantOk {
+				t.Errorf("operation ok = %v, want %v", got, tt.wantOk)
+			}
+
+			gotPops := []int{}
+			for v, ok := h.Pop(); ok; v, ok = h.Pop() {
+				gotPops = append(gotPops, v)
+			}
+			if !slices.Equal(gotPops, tt.wantPops) {
+				t.Errorf("Pop() sequence = %v, want %v", gotPops, tt.wantPops)
+			}
+		})
+	}
+}
+
+func TestPriorityQueueFix(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+
+	pq := NewHeap(func(a, b *task) bool { return a.priority < b.priority })
+	write := pq.Push(&task{"write", 3})
+	pq.Push(&task{"read", 2})
+	pq.Push(&task{"sleep", 5})
+
+	write.Value().priority = 1
+	pq.Fix(write)
+
+	var got []string
+	for v, ok := pq.Pop(); ok; v, ok = pq.Pop() {
+		got = append(got, v.name)
+	}
+	if want := []string{"write", "read", "sleep"}; !slices.Equal(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+}
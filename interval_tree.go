@@ -0,0 +1,220 @@
+package ds
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+var ErrEmptyInterval = errors.New("interval is empty")
+
+// Interval is the half-open range [Lo, Hi).
+type Interval[K any] struct {
+	Lo, Hi K
+}
+
+// intervalEntry is the value of a node of an IntervalTree.
+type intervalEntry[K, V any] struct {
+	value V
+	// maxHi is the greatest Hi in the subtree
+	maxHi K
+}
+
+type intervalNode[K, V any] = treeNode[Interval[K], intervalEntry[K, V]]
+
+// IntervalTree maps intervals to values. It is an AVL tree ordered by Lo
+// and then Hi whose nodes also store the greatest Hi of their subtrees, so
+// the intervals overlapping a range are found in O(log n + m) for m results.
+type IntervalTree[K, V any] struct {
+	root *intervalNode[K, V]
+	cmp  func(a, b K) int
+}
+
+func NewIntervalTree[K cmp.Ordered, V any]() *IntervalTree[K, V] {
+	return NewIntervalTreeFunc[K, V](cmp.Compare[K])
+}
+
+func NewIntervalTreeFunc[K, V any](cmp func(a, b K) int) *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{cmp: cmp}
+}
+
+func (t *IntervalTree[K, V]) Len() int {
+	return t.root.getSize()
+}
+
+func (t *IntervalTree[K, V]) compare(a, b Interval[K]) int {
+	if c := t.cmp(a.Lo, b.Lo); c != 0 {
+		return c
+	}
+	return t.cmp(a.Hi, b.Hi)
+}
+
+// subtreeMaxHi is the greatest Hi in the subtree of n, computed from the
+// maxima of its children.
+func (t *IntervalTree[K, V]) subtreeMaxHi(n *intervalNode[K, V]) K {
+	m := n.key.Hi
+	for _, c := range [2]*intervalNode[K, V]{n.left, n.right} {
+		if c != nil && t.cmp(c.value.maxHi, m) > 0 {
+			m = c.value.maxHi
+		}
+	}
+	return m
+}
+
+func (t *IntervalTree[K, V]) augment(n *intervalNode[K, V]) {
+	n.value.maxHi = t.subtreeMaxHi(n)
+}
+
+func (t *IntervalTree[K, V]) Get(lo, hi K) (V, bool) {
+	iv := Interval[K]{lo, hi}
+	n := t.root
+	for n != nil {
+		switch c := t.compare(iv, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Put maps [lo, hi) to v, replacing the value of an equal interval. It
+// returns ErrEmptyInterval unless lo < hi.
+func (t *IntervalTree[K, V]) Put(lo, hi K, v V) error {
+	if t.cmp(lo, hi) >= 0 {
+		return fmt.Errorf("%w [%v, %v)", ErrEmptyInterval, lo, hi)
+	}
+	t.root = treePut(t.root, Interval[K]{lo, hi}, intervalEntry[K, V]{value: v}, t.compare, t.augment)
+	return nil
+}
+
+// Delete removes [lo, hi) and reports whether it was in the tree.
+func (t *IntervalTree[K, V]) Delete(lo, hi K) bool {
+	var deleted bool
+	t.root = treeDelete(t.root, Interval[K]{lo, hi}, t.compare, t.augment, &deleted)
+	return deleted
+}
+
+func (t *IntervalTree[K, V]) Clear() {
+	t.root = nil
+}
+
+// All yields the intervals ordered by Lo and then Hi.
+func (t *IntervalTree[K, V]) All() iter.Seq2[Interval[K], V] {
+	return func(yield func(Interval[K], V) bool) {
+		always := func(*intervalNode[K, V]) bool { return true }
+		t.walk(t.root, always, always, always, yield)
+	}
+}
+
+// Overlapping yields the intervals that share a point with [lo, hi),
+// ordered by Lo and then Hi.
+func (t *IntervalTree[K, V]) Overlapping(lo, hi K) iter.Seq2[Interval[K], V] {
+	return func(yield func(Interval[K], V) bool) {
+		if t.cmp(lo, hi) >= 0 {
+			return
+		}
+		t.walk(t.root,
+			func(n *intervalNode[K, V]) bool { return t.cmp(n.value.maxHi, lo) > 0 },
+			func(n *intervalNode[K, V]) bool { return t.cmp(n.key.Lo, hi) < 0 && t.cmp(lo, n.key.Hi) < 0 },
+			func(n *intervalNode[K, V]) bool { return t.cmp(n.key.Lo, hi) < 0 },
+			yield)
+	}
+}
+
+// Stabbing yields the intervals that contain p, ordered by Lo and then Hi.
+func (t *IntervalTree[K, V]) Stabbing(p K) iter.Seq2[Interval[K], V] {
+	return func(yield func(Interval[K], V) bool) {
+		t.walk(t.root,
+			func(n *intervalNode[K, V]) bool { return t.cmp(n.value.maxHi, p) > 0 },
+			func(n *intervalNode[K, V]) bool { return t.cmp(n.key.Lo, p) <= 0 && t.cmp(p, n.key.Hi) < 0 },
+			func(n *intervalNode[K, V]) bool { return t.cmp(n.key.Lo, p) <= 0 },
+			yield)
+	}
+}
+
+// walk visits the subtree of n in order. It enters a subtree only if enter
+// holds for its root, yields the nodes that match, and goes right of a node
+// only if right holds for it. It reports whether the walk should continue.
+func (t *IntervalTree[K, V]) walk(
+	n *intervalNode[K, V],
+	enter, match, right func(*intervalNode[K, V]) bool,
+	yield func(Interval[K], V) bool,
+) bool {
+	if n == nil || !enter(n) {
+		return true
+	}
+	if !t.walk(n.left, enter, match, right, yield) {
+		return false
+	}
+	if match(n) && !yield(n.key, n.value.value) {
+		return false
+	}
+	if right(n) {
+		return t.walk(n.right, enter, match, right, yield)
+	}
+	return true
+}
+
+// Merged yields the union of the intervals as disjoint intervals in
+// ascending order. Intervals that touch, like [1, 3) and [3, 5), are merged.
+func (t *IntervalTree[K, V]) Merged() iter.Seq[Interval[K]] {
+	return func(yield func(Interval[K]) bool) {
+		var cur Interval[K]
+		started := false
+		for iv := range t.All() {
+			switch {
+			case !started:
+				cur, started = iv, true
+			case t.cmp(iv.Lo, cur.Hi) <= 0:
+				if t.cmp(iv.Hi, cur.Hi) > 0 {
+					cur.Hi = iv.Hi
+				}
+			default:
+				if !yield(cur) {
+					return
+				}
+				cur = iv
+			}
+		}
+		if started {
+			yield(cur)
+		}
+	}
+}
+
+// Validate checks the ordering, balance, height, size and maximum
+// invariants of the tree.
+func (t *IntervalTree[K, V]) Validate() error {
+	m := TreeMap[Interval[K], intervalEntry[K, V]]{root: t.root, cmp: t.compare}
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	return t.validate(t.root)
+}
+
+// validate checks that the intervals of the subtree of n are not empty and
+// that the maxima are right.
+func (t *IntervalTree[K, V]) validate(n *intervalNode[K, V]) error {
+	if n == nil {
+		return nil
+	}
+	if t.cmp(n.key.Lo, n.key.Hi) >= 0 {
+		return fmt.Errorf("interval %v is empty", n.key)
+	}
+	if err := t.validate(n.left); err != nil {
+		return err
+	}
+	if err := t.validate(n.right); err != nil {
+		return err
+	}
+	if want := t.subtreeMaxHi(n); t.cmp(n.value.maxHi, want) != 0 {
+		return fmt.Errorf("node %v has maximum %v, want %v", n.key, n.value.maxHi, want)
+	}
+	return nil
+}
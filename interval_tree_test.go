@@ -0,0 +1,264 @@
+package ds
+
+import (
+	"errors"
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// bookings holds hand-placed intervals for the tests; its values are the
+// interval lengths.
+var bookings = []Interval[int]{
+	{1, 3}, {2, 6}, {3, 4}, {8, 10}, {8, 12}, {15, 20}, {20, 21},
+}
+
+func newBookings() *IntervalTree[int, int] {
+	t := NewIntervalTree[int, int]()
+	for _, iv := range bookings {
+		t.Put(iv.Lo, iv.Hi, iv.Hi-iv.Lo)
+	}
+	return t
+}
+
+// collectIntervals collects the intervals of seq, checking the values of
+// the bookings.
+func collectIntervals(t *testing.T, seq iter.Seq2[Interval[int], int]) []Interval[int] {
+	t.Helper()
+	var ivs []Interval[int]
+	for iv, v := range seq {
+		if v != iv.Hi-iv.Lo {
+			t.Errorf("value of %v = %d, want %d", iv, v, iv.Hi-iv.Lo)
+		}
+		ivs = append(ivs, iv)
+	}
+	return ivs
+}
+
+func TestIntervalTreeOverlapping(t *testing.T) {
+	tree := newBookings()
+	tests := []struct {
+		lo, hi int
+		want   []Interval[int]
+	}{
+		{0, 1, nil},
+		{0, 2, []Interval[int]{{1, 3}}},
+		{3, 4, []Interval[int]{{2, 6}, {3, 4}}},
+		{6, 8, nil},
+		{5, 9, []Interval[int]{{2, 6}, {8, 10}, {8, 12}}},
+		{11, 16, []Interval[int]{{8, 12}, {15, 20}}},
+		{20, 30, []Interval[int]{{20, 21}}},
+		{0, 100, bookings},
+		{4, 4, nil},
+		{9, 2, nil},
+	}
+
+	for _, tt := range tests {
+		if got := collectIntervals(t, tree.Overlapping(tt.lo, tt.hi)); !slices.Equal(got, tt.want) {
+			t.Errorf("Overlapping(%d, %d) = %v, want %v", tt.lo, tt.hi, got, tt.want)
+		}
+	}
+}
+
+func TestIntervalTreeStabbing(t *testing.T) {
+	tree := newBookings()
+	tests := []struct {
+		p    int
+		want []Interval[int]
+	}{
+		{0, nil},
+		{1, []Interval[int]{{1, 3}}},
+		{3, []Interval[int]{{2, 6}, {3, 4}}},
+		{6, nil},
+		{9, []Interval[int]{{8, 10}, {8, 12}}},
+		{20, []Interval[int]{{20, 21}}},
+		{21, nil},
+	}
+
+	for _, tt := range tests {
+		if got := collectIntervals(t, tree.Stabbing(tt.p)); !slices.Equal(got, tt.want) {
+			t.Errorf("Stabbing(%d) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestIntervalTreeMerged(t *testing.T) {
+	tree := newBookings()
+	want := []Interval[int]{{1, 6}, {8, 12}, {15, 21}}
+	if got := slices.Collect(tree.Merged()); !slices.Equal(got, want) {
+		t.Errorf("Merged() = %v, want %v", got, want)
+	}
+
+	for got := range tree.Merged() {
+		if got != want[0] {
+			t.Errorf("first of Merged() = %v, want %v", got, want[0])
+		}
+		break
+	}
+	if got := slices.Collect(NewIntervalTree[int, int]().Merged()); got != nil {
+		t.Errorf("Merged() of an empty tree = %v, want none", got)
+	}
+}
+
+func TestIntervalTreePutDelete(t *testing.T) {
+	tree := newBookings()
+	if err := tree.Put(5, 5, 0); !errors.Is(err, ErrEmptyInterval) {
+		t.Errorf("Put(5, 5) returned %v, want ErrEmptyInterval", err)
+	}
+	if err := tree.Put(6, 2, 0); !errors.Is(err, ErrEmptyInterval) {
+		t.Errorf("Put(6, 2) returned %v, want ErrEmptyInterval", err)
+	}
+	tree.Put(2, 6, 100)
+	if v, ok := tree.Get(2, 6); !ok || v != 100 || tree.Len() != len(bookings) {
+		t.Errorf("Get(2, 6) after replacing = %d, %v with Len() %d", v, ok, tree.Len())
+	}
+
+	if !tree.Delete(8, 12) || tree.Delete(8, 12) || tree.Delete(8, 11) {
+		t.Error("Delete() results, want only the first Delete(8, 12) to succeed")
+	}
+	if _, ok := tree.Get(8, 12); ok {
+		t.Error("Get(8, 12) after Delete(8, 12) found it")
+	}
+	if got := collectIntervals(t, tree.Stabbing(11)); got != nil {
+		t.Errorf("Stabbing(11) after Delete(8, 12) = %v, want none", got)
+	}
+	if err := tree.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tree.Clear()
+	if tree.Len() != 0 || slices.Collect(tree.Merged()) != nil {
+		t.Error("Clear() left intervals")
+	}
+}
+
+// checkIntervalModel checks tree against a brute-force scan of model.
+func checkIntervalModel(t *testing.T, tree *IntervalTree[int, int], model map[Interval[int]]int, lo, hi int) {
+	t.Helper()
+	if err := tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if tree.Len() != len(model) {
+		t.Fatalf("Len() = %d, want %d", tree.Len(), len(model))
+	}
+	if got := maps.Collect(tree.All()); !maps.Equal(got, model) {
+		t.Fatalf("All() = %v, want %v", got, model)
+	}
+
+	sorted := slices.SortedFunc(maps.Keys(model), func(a, b Interval[int]) int {
+		return tree.compare(a, b)
+	})
+	var overlapping, stabbing []Interval[int]
+	for _, iv := range sorted {
+		if lo < hi && iv.Lo < hi && lo < iv.Hi {
+			overlapping = append(overlapping, iv)
+		}
+		if iv.Lo <= lo && lo < iv.Hi {
+			stabbing = append(stabbing, iv)
+		}
+	}
+	var got []Interval[int]
+	for iv := range tree.Overlapping(lo, hi) {
+		got = append(got, iv)
+	}
+	if !slices.Equal(got, overlapping) {
+		t.Fatalf("Overlapping(%d, %d) = %v, want %v", lo, hi, got, overlapping)
+	}
+	got = nil
+	for iv := range tree.Stabbing(lo) {
+		got = append(got, iv)
+	}
+	if !slices.Equal(got, stabbing) {
+		t.Fatalf("Stabbing(%d) = %v, want %v", lo, got, stabbing)
+	}
+
+	// the merged intervals cover exactly the covered points and are
+	// separated by uncovered ones
+	merged := slices.Collect(tree.Merged())
+	for i, m := range merged {
+		if i > 0 && merged[i-1].Hi >= m.Lo {
+			t.Fatalf("Merged() = %v, intervals %d and %d are not separated", merged, i-1, i)
+		}
+	}
+	for p := -1; p <= 300; p++ {
+		covered := false
+		for iv := range model {
+			covered = covered || iv.Lo <= p && p < iv.Hi
+		}
+		inMerged := slices.ContainsFunc(merged, func(m Interval[int]) bool { return m.Lo <= p && p < m.Hi })
+		if covered != inMerged {
+			t.Fatalf("Merged() = %v, point %d covered %v", merged, p, covered)
+		}
+	}
+}
+
+// applyIntervalOps runs ops encoded three bytes each on tree and model: the
+// first byte picks Put or Delete, the others the bounds.
+func applyIntervalOps(t *testing.T, tree *IntervalTree[int, int], model map[Interval[int]]int, ops []byte) {
+	for i := 0; i+2 < len(ops); i += 3 {
+		lo, hi := int(ops[i+1]), int(ops[i+1])+int(ops[i+2]%32)
+		iv := Interval[int]{lo, hi}
+		if ops[i]%3 == 0 {
+			_, want := model[iv]
+			delete(model, iv)
+			if got := tree.Delete(lo, hi); got != want {
+				t.Fatalf("Delete(%d, %d) = %v, want %v", lo, hi, got, want)
+			}
+			continue
+		}
+		err := tree.Put(lo, hi, int(ops[i]))
+		if lo == hi {
+			if !errors.Is(err, ErrEmptyInterval) {
+				t.Fatalf("Put(%d, %d) returned %v, want ErrEmptyInterval", lo, hi, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Put(%d, %d) returned %v", lo, hi, err)
+		}
+		model[iv] = int(ops[i])
+	}
+}
+
+func TestIntervalTreeModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(41, 42))
+	tree := NewIntervalTree[int, int]()
+	model := make(map[Interval[int]]int)
+	for range 200 {
+		ops := make([]byte, 3*r.IntN(10))
+		for i := range ops {
+			ops[i] = byte(r.IntN(256))
+		}
+		applyIntervalOps(t, tree, model, ops)
+		lo := r.IntN(290)
+		checkIntervalModel(t, tree, model, lo, lo+r.IntN(40))
+	}
+}
+
+func FuzzIntervalTree(f *testing.F) {
+	f.Add([]byte{1, 10, 5, 2, 12, 8, 0, 10, 5}, 11, 3)
+	f.Add([]byte{1, 0, 31, 1, 1, 1, 1, 2, 1, 0, 1, 1}, 1, 1)
+	f.Add([]byte{2, 200, 40, 4, 230, 3, 5, 20, 0}, 231, 10)
+	f.Fuzz(func(t *testing.T, ops []byte, lo, n int) {
+		tree := NewIntervalTree[int, int]()
+		model := make(map[Interval[int]]int)
+		applyIntervalOps(t, tree, model, ops)
+		checkIntervalModel(t, tree, model, lo%300, lo%300+n%40)
+	})
+}
+
+func BenchmarkIntervalTreeOverlapping(b *testing.B) {
+	r := rand.New(rand.NewPCG(1, 2))
+	tree := NewIntervalTree[int, int]()
+	for i := range 1 << 16 {
+		lo := r.IntN(1 << 24)
+		tree.Put(lo, lo+1+r.IntN(1<<10), i)
+	}
+	for i := 0; b.Loop(); i++ {
+		lo := i * 7919 % (1 << 24)
+		for range tree.Overlapping(lo, lo+1<<10) {
+		}
+	}
+}
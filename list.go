@@ -0,0 +1,44 @@
+package ds
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+type List[E comparable] interface {
+	fmt.Stringer
+	Length() int
+	Get(i int) (E, error)
+	FindFirst(v E) int
+	FindLast(v E) int
+	Append(v E)
+	Insert(v E, i int) error
+	Reverse()
+	Delete(i int) (E, error)
+	DeleteAll(v E)
+	Clear()
+	Iter() iter.Seq2[int, E]
+}
+
+var _ List[int] = (*cslList[int])(nil)
+
+func formatList[E any](name string, n int, vs iter.Seq2[int, E]) string {
+	if n == 0 {
+		return name + "{  }"
+	}
+
+	var b strings.Builder
+	b.Grow(len(name) + 3 + n*2)
+	b.WriteString(name + "{ ")
+	for _, v := range vs {
+		switch v := any(v).(type) {
+		case rune:
+			b.WriteString(fmt.Sprintf("%c ", v))
+		default:
+			b.WriteString(fmt.Sprintf("%v ", v))
+		}
+	}
+	b.WriteString("}")
+	return b.String()
+}
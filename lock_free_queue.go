@@ -0,0 +1,77 @@
+package ds
+
+import "sync/atomic"
+
+type lfNode[E any] struct {
+	data E
+	next atomic.Pointer[lfNode[E]]
+}
+
+// LockFreeQueue is a Michael-Scott queue: head always points to a sentinel
+// node and the first element is stored in head.next.
+type LockFreeQueue[E any] struct {
+	head atomic.Pointer[lfNode[E]]
+	tail atomic.Pointer[lfNode[E]]
+	len  atomic.Int64
+}
+
+func NewLockFreeQueue[E any](vs ...E) *LockFreeQueue[E] {
+	q := &LockFreeQueue[E]{}
+	sentinel := &lfNode[E]{}
+	q.head.Store(sentinel)
+	q.tail.Store(sentinel)
+	for _, v := range vs {
+		q.Enqueue(v)
+	}
+	return q
+}
+
+// Len is only a hint while other goroutines are enqueuing or dequeuing.
+func (q *LockFreeQueue[E]) Len() int {
+	return max(int(q.len.Load()), 0)
+}
+
+func (q *LockFreeQueue[E]) Enqueue(v E) {
+	node := &lfNode[E]{data: v}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// tail is lagging behind, help the other producer to move it
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, node) {
+			q.tail.CompareAndSwap(tail, node)
+			q.len.Add(1)
+			return
+		}
+	}
+}
+
+func (q *LockFreeQueue[E]) Dequeue() (E, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zero E
+			return zero, false
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		v := next.data
+		if q.head.CompareAndSwap(head, next) {
+			q.len.Add(-1)
+			return v, true
+		}
+	}
+}
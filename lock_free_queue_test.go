@@ -0,0 +1,202 @@
+package ds
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestLockFreeQueue(t *testing.T) {
+	tests := []struct {
+		name      string
+		init      []int
+		enqueue   []int
+		dequeues  int
+		wantVals  []int
+		wantOks   []bool
+		wantLen   int
+		wantAfter []int
+	}{
+		{
+			name:      "empty queue",
+			init:      []int{},
+			dequeues:  1,
+			wantVals:  []int{0},
+			wantOks:   []bool{false},
+			wantLen:   0,
+			wantAfter: []int{},
+		},
+		{
+			name:      "fifo order",
+			init:      []int{1, 2},
+			enqueue:   []int{3, 4},
+			dequeues:  3,
+			wantVals:  []int{1, 2, 3},
+			wantOks:   []bool{true, true, true},
+			wantLen:   1,
+			wantAfter: []int{4},
+		},
+		{
+			name:      "drain past empty",
+			init:      []int{1},
+			enqueue:   []int{2},
+			dequeues:  3,
+			wantVals:  []int{1, 2, 0},
+			wantOks:   []bool{true, true, false},
+			wantLen:   0,
+			wantAfter: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewLockFreeQueue(tt.init...)
+			for _, v := range tt.enqueue {
+				q.Enqueue(v)
+			}
+
+			var gotVals []int
+			var gotOks []bool
+			for range tt.dequeues {
+				v, ok := q.Dequeue()
+				gotVals = append(gotVals, v)
+				gotOks = append(gotOks, ok)
+			}
+
+			if !slices.Equal(gotVals, tt.wantVals) || !slices.Equal(gotOks, tt.wantOks) {
+				t.Errorf("Dequeue() returned %v %v, want %v %v", gotVals, gotOks, tt.wantVals, tt.wantOks)
+			}
+			if got := q.Len(); got != tt.wantLen {
+				t.Errorf("Len() = %d, want %d", got, tt.wantLen)
+			}
+
+			gotAfter := []int{}
+			for v, ok := q.Dequeue(); ok; v, ok = q.Dequeue() {
+				gotAfter = append(gotAfter, v)
+			}
+			if !slices.Equal(gotAfter, tt.wantAfter) {
+				t.Errorf("remaining elements = %v, want %v", gotAfter, tt.wantAfter)
+			}
+		})
+	}
+}
+
+func TestLockFreeQueueStress(t *testing.T) {
+	type item struct {
+		producer int
+		seq      int
+	}
+
+	producers := 4
+	consumers := 4
+	perProducer := 5000
+	if testing.Short() {
+		perProducer = 500
+	}
+
+	q := NewLockFreeQueue[item]()
+	var produced sync.WaitGroup
+	for p := range producers {
+		produced.Add(1)
+		go func() {
+			defer produced.Done()
+			for i := range perProducer {
+				q.Enqueue(item{p, i})
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	results := make([][]item, consumers)
+	var consumed sync.WaitGroup
+	for c := range consumers {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for {
+				v, ok := q.Dequeue()
+				if ok {
+					results[c] = append(results[c], v)
+					continue
+				}
+				select {
+				case <-done:
+					if v, ok := q.Dequeue(); ok {
+						results[c] = append(results[c], v)
+						continue
+					}
+					return
+				default:
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	produced.Wait()
+	close(done)
+	consumed.Wait()
+
+	seen := make([][]bool, producers)
+	for p := range seen {
+		seen[p] = make([]bool, perProducer)
+	}
+	total := 0
+	for c, items := range results {
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = -1
+		}
+		for _, it := range items {
+			if seen[it.producer][it.seq] {
+				t.Fatalf("item %v dequeued twice", it)
+			}
+			seen[it.producer][it.seq] = true
+			if it.seq <= last[it.producer] {
+				t.Fatalf("consumer %d got %v after seq %d, want FIFO order per producer", c, it, last[it.producer])
+			}
+			last[it.producer] = it.seq
+			total++
+		}
+	}
+
+	if total != producers*perProducer {
+		t.Errorf("dequeued %d items, want %d", total, producers*perProducer)
+	}
+	if got := q.Len(); got != 0 {
+		t.Errorf("Len() after draining = %d, want 0", got)
+	}
+}
+
+func BenchmarkLockFreeQueue(b *testing.B) {
+	q := NewLockFreeQueue[int]()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%2 == 0 {
+				q.Enqueue(i)
+			} else {
+				q.Dequeue()
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkSyncCSLListQueue(b *testing.B) {
+	l := NewSyncList[int](NewCSLList[int]())
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%2 == 0 {
+				l.Append(i)
+			} else {
+				l.PopFrontIfLen(1)
+			}
+			i++
+		}
+	})
+}
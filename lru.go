@@ -0,0 +1,215 @@
+package ds
+
+import (
+	"iter"
+	"time"
+)
+
+type lruEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+type LRUOption[K comparable, V any] func(*LRU[K, V])
+
+// WithEvictCallback calls fn for every entry dropped because the cache is
+// full or the entry has expired. Remove and Clear do not call it.
+func WithEvictCallback[K comparable, V any](fn func(K, V)) LRUOption[K, V] {
+	return func(c *LRU[K, V]) {
+		c.onEvict = fn
+	}
+}
+
+// WithTTL sets the lifetime of the entries added by Put.
+func WithTTL[K comparable, V any](ttl time.Duration) LRUOption[K, V] {
+	return func(c *LRU[K, V]) {
+		c.ttl = ttl
+	}
+}
+
+// WithClock replaces time.Now as the source of the current time.
+func WithClock[K comparable, V any](now func() time.Time) LRUOption[K, V] {
+	return func(c *LRU[K, V]) {
+		c.now = now
+	}
+}
+
+// LRU is a cache of at most capacity entries that evicts the least recently
+// used entry first. It is not safe for concurrent use.
+type LRU[K comparable, V any] struct {
+	capacity int
+	items    map[K]*CDLNode[*lruEntry[K, V]]
+	// order keeps the most recently used entry at the front
+	order   *CDLList[*lruEntry[K, V]]
+	onEvict func(K, V)
+	ttl     time.Duration
+	now     func() time.Time
+	hits    int
+	misses  int
+}
+
+func NewLRU[K comparable, V any](capacity int, opts ...LRUOption[K, V]) *LRU[K, V] {
+	c := &LRU[K, V]{
+		capacity: max(capacity, 1),
+		items:    make(map[K]*CDLNode[*lruEntry[K, V]]),
+		order:    NewCDLList[*lruEntry[K, V]](),
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *LRU[K, V]) Len() int {
+	return len(c.items)
+}
+
+func (c *LRU[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Stats returns the number of Get calls that found and missed an entry.
+func (c *LRU[K, V]) Stats() (hits, misses int) {
+	return c.hits, c.misses
+}
+
+func (c *LRU[K, V]) ResetStats() {
+	c.hits, c.misses = 0, 0
+}
+
+func (c *LRU[K, V]) expired(e *lruEntry[K, V]) bool {
+	return !e.expires.IsZero() && !c.now().Before(e.expires)
+}
+
+// lookup returns the node of k, evicting it first if it has expired.
+func (c *LRU[K, V]) lookup(k K) *CDLNode[*lruEntry[K, V]] {
+	n, ok := c.items[k]
+	if !ok {
+		return nil
+	}
+	if c.expired(n.Value) {
+		c.evict(n)
+		return nil
+	}
+	return n
+}
+
+// Get returns the value of k and marks it as the most recently used.
+func (c *LRU[K, V]) Get(k K) (V, bool) {
+	n := c.lookup(k)
+	if n == nil {
+		c.misses++
+		var zero V
+		return zero, false
+	}
+	c.hits++
+	c.order.MoveToFront(n)
+	return n.Value.value, true
+}
+
+// Peek returns the value of k without updating its recency or the stats.
+func (c *LRU[K, V]) Peek(k K) (V, bool) {
+	n := c.lookup(k)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+	return n.Value.value, true
+}
+
+func (c *LRU[K, V]) Contains(k K) bool {
+	return c.lookup(k) != nil
+}
+
+// Put adds or replaces k with the default lifetime and reports whether
+// another entry was evicted to make room.
+func (c *LRU[K, V]) Put(k K, v V) bool {
+	return c.PutTTL(k, v, c.ttl)
+}
+
+// PutTTL is like Put, but the entry expires after ttl. A ttl of zero or less
+// means the entry never expires.
+func (c *LRU[K, V]) PutTTL(k K, v V, ttl time.Duration) bool {
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.now().Add(ttl)
+	}
+	if n, ok := c.items[k]; ok {
+		n.Value.value, n.Value.expires = v, expires
+		c.order.MoveToFront(n)
+		return false
+	}
+	c.items[k] = c.order.PushFront(&lruEntry[K, V]{k, v, expires})
+	return c.shrink() > 0
+}
+
+func (c *LRU[K, V]) Remove(k K) bool {
+	n, ok := c.items[k]
+	if !ok {
+		return false
+	}
+	delete(c.items, k)
+	c.order.Remove(n)
+	return true
+}
+
+// Resize changes the capacity, evicting the least recently used entries
+// that no longer fit, and returns how many were evicted.
+func (c *LRU[K, V]) Resize(capacity int) int {
+	c.capacity = max(capacity, 1)
+	return c.shrink()
+}
+
+func (c *LRU[K, V]) shrink() int {
+	evicted := 0
+	for len(c.items) > c.capacity {
+		c.evict(c.order.Back())
+		evicted++
+	}
+	return evicted
+}
+
+func (c *LRU[K, V]) evict(n *CDLNode[*lruEntry[K, V]]) {
+	e := c.order.Remove(n)
+	delete(c.items, e.key)
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+// Purge evicts every expired entry and returns how many were evicted.
+func (c *LRU[K, V]) Purge() int {
+	evicted := 0
+	n := c.order.Front()
+	for range c.order.Length() {
+		next := n.Next()
+		if c.expired(n.Value) {
+			c.evict(n)
+			evicted++
+		}
+		n = next
+	}
+	return evicted
+}
+
+func (c *LRU[K, V]) Clear() {
+	clear(c.items)
+	c.order.Clear()
+}
+
+// All yields the live entries from the most to the least recently used
+// without updating their recency.
+func (c *LRU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, e := range c.order.Iter() {
+			if c.expired(e) {
+				continue
+			}
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
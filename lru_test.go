@@ -0,0 +1,246 @@
+package ds
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func lruKeys[V any](c *LRU[string, V]) []string {
+	var ks []string
+	for k := range c.All() {
+		ks = append(ks, k)
+	}
+	return ks
+}
+
+func TestLRUEviction(t *testing.T) {
+	tests := []struct {
+		name        string
+		capacity    int
+		op          func(c *LRU[string, int])
+		wantKeys    []string
+		wantEvicted []string
+	}{
+		{
+			name:     "fills up to capacity",
+			capacity: 3,
+			op: func(c *LRU[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			wantKeys: []string{"c", "b", "a"},
+		},
+		{
+			name:     "evicts least recently put",
+			capacity: 2,
+			op: func(c *LRU[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+			},
+			wantKeys:    []string{"c", "b"},
+			wantEvicted: []string{"a"},
+		},
+		{
+			name:     "get refreshes recency",
+			capacity: 2,
+			op: func(c *LRU[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Get("a")
+				c.Put("c", 3)
+			},
+			wantKeys:    []string{"c", "a"},
+			wantEvicted: []string{"b"},
+		},
+		{
+			name:     "peek keeps recency",
+			capacity: 2,
+			op: func(c *LRU[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Peek("a")
+				c.Put("c", 3)
+			},
+			wantKeys:    []string{"c", "b"},
+			wantEvicted: []string{"a"},
+		},
+		{
+			name:     "overwrite refreshes recency",
+			capacity: 2,
+			op: func(c *LRU[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("a", 10)
+				c.Put("c", 3)
+			},
+			wantKeys:    []string{"c", "a"},
+			wantEvicted: []string{"b"},
+		},
+		{
+			name:     "remove does not call back",
+			capacity: 2,
+			op: func(c *LRU[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Remove("a")
+				c.Remove("x")
+				c.Put("c", 3)
+			},
+			wantKeys: []string{"c", "b"},
+		},
+		{
+			name:     "shrink evicts oldest",
+			capacity: 4,
+			op: func(c *LRU[string, int]) {
+				for i, k := range []string{"a", "b", "c", "d"} {
+					c.Put(k, i)
+				}
+				c.Resize(2)
+			},
+			wantKeys:    []string{"d", "c"},
+			wantEvicted: []string{"a", "b"},
+		},
+		{
+			name:     "capacity below one is raised",
+			capacity: 0,
+			op: func(c *LRU[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+			},
+			wantKeys:    []string{"b"},
+			wantEvicted: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var evicted []string
+			c := NewLRU(tt.capacity, WithEvictCallback(func(k string, _ int) {
+				evicted = append(evicted, k)
+			}))
+			tt.op(c)
+
+			if got := lruKeys(c); !slices.Equal(got, tt.wantKeys) {
+				t.Errorf("keys = %v, want %v", got, tt.wantKeys)
+			}
+			if !slices.Equal(evicted, tt.wantEvicted) {
+				t.Errorf("evicted = %v, want %v", evicted, tt.wantEvicted)
+			}
+			if got := c.Len(); got != len(tt.wantKeys) {
+				t.Errorf("Len() = %d, want %d", got, len(tt.wantKeys))
+			}
+		})
+	}
+}
+
+func TestLRUGetPut(t *testing.T) {
+	c := NewLRU[string, int](2)
+
+	if c.Put("a", 1) || c.Put("b", 2) {
+		t.Error("Put() into a cache with room reported an eviction")
+	}
+	if !c.Put("c", 3) {
+		t.Error("Put() into a full cache reported no eviction")
+	}
+
+	if v, ok := c.Get("b"); v != 2 || !ok {
+		t.Errorf("Get(b) = (%v, %v), want (2, true)", v, ok)
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get(a) found an evicted key")
+	}
+	if v, ok := c.Peek("c"); v != 3 || !ok {
+		t.Errorf("Peek(c) = (%v, %v), want (3, true)", v, ok)
+	}
+	if _, ok := c.Peek("a"); ok {
+		t.Error("Peek(a) found an evicted key")
+	}
+
+	if hits, misses := c.Stats(); hits != 1 || misses != 1 {
+		t.Errorf("Stats() = (%d, %d), want (1, 1)", hits, misses)
+	}
+	c.ResetStats()
+	if hits, misses := c.Stats(); hits != 0 || misses != 0 {
+		t.Errorf("Stats() after ResetStats() = (%d, %d), want (0, 0)", hits, misses)
+	}
+
+	if got := c.Resize(5); got != 0 || c.Cap() != 5 {
+		t.Errorf("Resize(5) = %d with Cap() %d, want 0 with 5", got, c.Cap())
+	}
+	c.Clear()
+	if c.Len() != 0 || c.Contains("b") {
+		t.Errorf("cache is not empty after Clear(): %v", lruKeys(c))
+	}
+}
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.t
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func TestLRUTTL(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	var evicted []string
+	c := NewLRU(10,
+		WithClock[string, int](clock.Now),
+		WithTTL[string, int](time.Minute),
+		WithEvictCallback(func(k string, _ int) { evicted = append(evicted, k) }),
+	)
+
+	c.Put("default", 1)
+	c.PutTTL("short", 2, time.Second)
+	c.PutTTL("forever", 3, 0)
+
+	clock.Advance(time.Second)
+	if _, ok := c.Get("short"); ok {
+		t.Error("Get(short) found an expired entry")
+	}
+	if _, ok := c.Peek("default"); !ok {
+		t.Error("Peek(default) missed a live entry")
+	}
+	if want := []string{"short"}; !slices.Equal(evicted, want) {
+		t.Errorf("evicted = %v, want %v", evicted, want)
+	}
+
+	clock.Advance(time.Minute)
+	if got := lruKeys(c); !slices.Equal(got, []string{"forever"}) {
+		t.Errorf("keys = %v, want [forever]", got)
+	}
+	if got := c.Purge(); got != 1 {
+		t.Errorf("Purge() = %d, want 1", got)
+	}
+	if got := c.Len(); got != 1 {
+		t.Errorf("Len() after Purge() = %d, want 1", got)
+	}
+
+	c.Put("default", 4)
+	clock.Advance(30 * time.Second)
+	c.Put("default", 5)
+	clock.Advance(45 * time.Second)
+	if v, ok := c.Get("default"); v != 5 || !ok {
+		t.Errorf("Get(default) after overwrite = (%v, %v), want (5, true)", v, ok)
+	}
+	if hits, misses := c.Stats(); hits != 1 || misses != 1 {
+		t.Errorf("Stats() = (%d, %d), want (1, 1)", hits, misses)
+	}
+}
+
+func BenchmarkLRUGetPut(b *testing.B) {
+	c := NewLRU[int, int](1000)
+	for i := 0; b.Loop(); i++ {
+		k := i * 7919 % 2000
+		if _, ok := c.Get(k); !ok {
+			c.Put(k, i)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package ds
+
+import (
+	"iter"
+	"maps"
+)
+
+// MultiSet is an unordered collection that counts the occurrences of its
+// elements. The algebra methods return new multisets and leave their
+// operands unchanged.
+type MultiSet[E comparable] struct {
+	m   map[E]int
+	len int
+}
+
+func NewMultiSet[E comparable](vs ...E) *MultiSet[E] {
+	s := &MultiSet[E]{m: make(map[E]int)}
+	for _, v := range vs {
+		s.Add(v)
+	}
+	return s
+}
+
+// NewMultiSetFromList counts the elements of l.
+func NewMultiSetFromList[E comparable](l List[E]) *MultiSet[E] {
+	s := &MultiSet[E]{m: make(map[E]int)}
+	for _, v := range l.Iter() {
+		s.Add(v)
+	}
+	return s
+}
+
+// Len returns the number of occurrences of all elements.
+func (s *MultiSet[E]) Len() int {
+	return s.len
+}
+
+// Distinct returns the number of distinct elements.
+func (s *MultiSet[E]) Distinct() int {
+	return len(s.m)
+}
+
+// Add adds one occurrence of v and returns its new count.
+func (s *MultiSet[E]) Add(v E) int {
+	return s.AddN(v, 1)
+}
+
+// AddN adds n occurrences of v and returns its new count.
+func (s *MultiSet[E]) AddN(v E, n int) int {
+	if n > 0 {
+		s.m[v] += n
+		s.len += n
+	}
+	return s.m[v]
+}
+
+// Remove removes one occurrence of v and reports whether there was one.
+func (s *MultiSet[E]) Remove(v E) bool {
+	return s.RemoveN(v, 1) > 0
+}
+
+// RemoveN removes up to n occurrences of v and returns how many were
+// removed.
+func (s *MultiSet[E]) RemoveN(v E, n int) int {
+	c := s.m[v]
+	n = max(min(n, c), 0)
+	if n == c {
+		delete(s.m, v)
+	} else {
+		s.m[v] = c - n
+	}
+	s.len -= n
+	return n
+}
+
+// RemoveAll removes every occurrence of v and returns how many there were.
+func (s *MultiSet[E]) RemoveAll(v E) int {
+	return s.RemoveN(v, s.m[v])
+}
+
+func (s *MultiSet[E]) Count(v E) int {
+	return s.m[v]
+}
+
+func (s *MultiSet[E]) Contains(v E) bool {
+	return s.m[v] > 0
+}
+
+func (s *MultiSet[E]) Clear() {
+	clear(s.m)
+	s.len = 0
+}
+
+func (s *MultiSet[E]) Clone() *MultiSet[E] {
+	return &MultiSet[E]{maps.Clone(s.m), s.len}
+}
+
+// combine builds a multiset with the count fn returns for every element of s
+// and o.
+func (s *MultiSet[E]) combine(o *MultiSet[E], fn func(a, b int) int) *MultiSet[E] {
+	r := NewMultiSet[E]()
+	for v, a := range s.m {
+		r.AddN(v, fn(a, o.m[v]))
+	}
+	for v, b := range o.m {
+		if _, ok := s.m[v]; !ok {
+			r.AddN(v, fn(0, b))
+		}
+	}
+	return r
+}
+
+// Union keeps every element as many times as it occurs in s or o, whichever
+// is more.
+func (s *MultiSet[E]) Union(o *MultiSet[E]) *MultiSet[E] {
+	return s.combine(o, func(a, b int) int { return max(a, b) })
+}
+
+// Sum keeps every element as many times as it occurs in s and o together.
+func (s *MultiSet[E]) Sum(o *MultiSet[E]) *MultiSet[E] {
+	return s.combine(o, func(a, b int) int { return a + b })
+}
+
+// Intersect keeps every element as many times as it occurs in both s and o.
+func (s *MultiSet[E]) Intersect(o *MultiSet[E]) *MultiSet[E] {
+	return s.combine(o, func(a, b int) int { return min(a, b) })
+}
+
+// Difference keeps the occurrences in s that are not matched by ones in o.
+func (s *MultiSet[E]) Difference(o *MultiSet[E]) *MultiSet[E] {
+	return s.combine(o, func(a, b int) int { return a - b })
+}
+
+// SymmetricDifference keeps the occurrences in either s or o that are not
+// matched by ones in the other.
+func (s *MultiSet[E]) SymmetricDifference(o *MultiSet[E]) *MultiSet[E] {
+	return s.combine(o, func(a, b int) int { return max(a-b, b-a) })
+}
+
+// IsSubset reports whether no element occurs in s more times than in o.
+func (s *MultiSet[E]) IsSubset(o *MultiSet[E]) bool {
+	for v, c := range s.m {
+		if c > o.m[v] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *MultiSet[E]) Equal(o *MultiSet[E]) bool {
+	return s.len == o.len && s.IsSubset(o)
+}
+
+// Iter yields the distinct elements with their counts in no particular
+// order.
+func (s *MultiSet[E]) Iter() iter.Seq2[E, int] {
+	return maps.All(s.m)
+}
+
+// ToSet returns the distinct elements.
+func (s *MultiSet[E]) ToSet() *Set[E] {
+	r := &Set[E]{make(map[E]struct{}, len(s.m))}
+	for v := range s.m {
+		r.m[v] = struct{}{}
+	}
+	return r
+}
+
+// ToList returns every occurrence in a cslList, with equal elements next to
+// each other.
+func (s *MultiSet[E]) ToList() *cslList[E] {
+	l := NewCSLList[E]()
+	for v, c := range s.m {
+		for range c {
+			l.Append(v)
+		}
+	}
+	return l
+}
@@ -0,0 +1,107 @@
+package ds
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestMultiSetAddRemove(t *testing.T) {
+	s := NewMultiSet("a", "b", "a")
+	if s.Len() != 3 || s.Distinct() != 2 {
+		t.Errorf("Len(), Distinct() = %d, %d, want 3, 2", s.Len(), s.Distinct())
+	}
+
+	if got := s.Add("a"); got != 3 {
+		t.Errorf("Add(a) = %d, want 3", got)
+	}
+	if got := s.AddN("c", 4); got != 4 {
+		t.Errorf("AddN(c, 4) = %d, want 4", got)
+	}
+	if got := s.AddN("d", -1); got != 0 || s.Contains("d") {
+		t.Errorf("AddN(d, -1) = %d, want 0 without adding d", got)
+	}
+
+	if !s.Remove("b") || s.Remove("b") {
+		t.Error("Remove(b) twice, want true then false")
+	}
+	if got := s.RemoveN("c", 3); got != 3 || s.Count("c") != 1 {
+		t.Errorf("RemoveN(c, 3) = %d leaving %d, want 3 leaving 1", got, s.Count("c"))
+	}
+	if got := s.RemoveN("c", 5); got != 1 || s.Contains("c") {
+		t.Errorf("RemoveN(c, 5) = %d, want 1 removing c", got)
+	}
+	if got := s.RemoveAll("a"); got != 3 {
+		t.Errorf("RemoveAll(a) = %d, want 3", got)
+	}
+	if s.Len() != 0 || s.Distinct() != 0 {
+		t.Errorf("Len(), Distinct() = %d, %d, want 0, 0", s.Len(), s.Distinct())
+	}
+}
+
+func TestMultiSetAlgebra(t *testing.T) {
+	a := NewMultiSet("x", "x", "x", "y", "z")
+	b := NewMultiSet("x", "y", "y", "w")
+
+	tests := []struct {
+		op   string
+		got  *MultiSet[string]
+		want map[string]int
+	}{
+		{"Union", a.Union(b), map[string]int{"x": 3, "y": 2, "z": 1, "w": 1}},
+		{"Sum", a.Sum(b), map[string]int{"x": 4, "y": 3, "z": 1, "w": 1}},
+		{"Intersect", a.Intersect(b), map[string]int{"x": 1, "y": 1}},
+		{"Difference", a.Difference(b), map[string]int{"x": 2, "z": 1}},
+		{"SymmetricDifference", a.SymmetricDifference(b), map[string]int{"x": 2, "y": 1, "z": 1, "w": 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.op, func(t *testing.T) {
+			if got := maps.Collect(tt.got.Iter()); !maps.Equal(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.op, got, tt.want)
+			}
+			total := 0
+			for _, c := range tt.want {
+				total += c
+			}
+			if tt.got.Len() != total {
+				t.Errorf("%s().Len() = %d, want %d", tt.op, tt.got.Len(), total)
+			}
+		})
+	}
+
+	if a.Len() != 5 || b.Len() != 4 {
+		t.Errorf("operands changed to %v and %v", maps.Collect(a.Iter()), maps.Collect(b.Iter()))
+	}
+	if a.IsSubset(b) || !a.Intersect(b).IsSubset(b) || !b.IsSubset(a.Sum(b)) {
+		t.Error("IsSubset() results, want only the intersection and the operand to be subsets")
+	}
+	if !a.Equal(a.Clone()) || a.Equal(a.Union(b)) {
+		t.Error("Equal() results, want a equal to its clone only")
+	}
+}
+
+func TestMultiSetList(t *testing.T) {
+	l := NewCSLList(3, 1, 3, 2, 3)
+	s := NewMultiSetFromList[int](l)
+	if want := map[int]int{1: 1, 2: 1, 3: 3}; !maps.Equal(maps.Collect(s.Iter()), want) {
+		t.Errorf("NewMultiSetFromList() = %v, want %v", maps.Collect(s.Iter()), want)
+	}
+
+	back := s.ToList()
+	if back.Length() != 5 {
+		t.Fatalf("ToList() = %v, want 5 elements", back)
+	}
+	if first, last := back.FindFirst(3), back.FindLast(3); last-first != 2 {
+		t.Errorf("ToList() = %v, want the occurrences of 3 next to each other", back)
+	}
+	if got := NewMultiSetFromList[int](back); !got.Equal(s) {
+		t.Errorf("counts of ToList() = %v, want %v", maps.Collect(got.Iter()), maps.Collect(s.Iter()))
+	}
+
+	if set := s.ToSet(); set.Len() != 3 || !set.Contains(2) {
+		t.Errorf("ToSet() has %d elements, want 1, 2 and 3", set.Len())
+	}
+	if l.Distinct(); l.Length() != 3 {
+		t.Errorf("Distinct() left %v, want 3 elements", l)
+	}
+}
@@ -0,0 +1,140 @@
+package ds
+
+import "sync"
+
+// nodeAllocator recycles nodes released by Delete, DeleteAll and Clear.
+// Nodes of a list with an allocator are reused, so such a list must not be
+// modified while it is being iterated.
+type nodeAllocator[E comparable] interface {
+	alloc() *node[E]
+	free(n *node[E])
+	// fork returns an allocator for a cloned list, which must not share
+	// unsynchronized state with the original one.
+	fork() nodeAllocator[E]
+}
+
+type ListOption[E comparable] func(*cslList[E])
+
+// WithFreeList keeps up to max released nodes per list.
+func WithFreeList[E comparable](max int) ListOption[E] {
+	return func(l *cslList[E]) {
+		l.alloc = &freeList[E]{max: max}
+	}
+}
+
+// WithNodePool recycles nodes through a sync.Pool shared by the list and its
+// clones.
+func WithNodePool[E comparable]() ListOption[E] {
+	return func(l *cslList[E]) {
+		l.alloc = &poolAllocator[E]{pool: &sync.Pool{
+			New: func() any { return new(node[E]) },
+		}}
+	}
+}
+
+// WithArena allocates nodes in blocks of chunk nodes and keeps every released
+// node for reuse.
+func WithArena[E comparable](chunk int) ListOption[E] {
+	return func(l *cslList[E]) {
+		l.alloc = &arenaAllocator[E]{size: max(chunk, 1)}
+	}
+}
+
+func NewCSLListWith[E comparable](opts ...ListOption[E]) *cslList[E] {
+	l := &cslList[E]{}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+func (l *cslList[E]) newNode(v E, next *node[E]) *node[E] {
+	if l.alloc == nil {
+		return &node[E]{v, next}
+	}
+	n := l.alloc.alloc()
+	n.data, n.next = v, next
+	return n
+}
+
+func (l *cslList[E]) freeNode(n *node[E]) {
+	if l.alloc == nil {
+		return
+	}
+	*n = node[E]{}
+	l.alloc.free(n)
+}
+
+type freeList[E comparable] struct {
+	head *node[E]
+	len  int
+	max  int
+}
+
+func (f *freeList[E]) alloc() *node[E] {
+	if f.head == nil {
+		return new(node[E])
+	}
+	n := f.head
+	f.head = n.next
+	f.len--
+	return n
+}
+
+func (f *freeList[E]) free(n *node[E]) {
+	if f.len >= f.max {
+		return
+	}
+	n.next = f.head
+	f.head = n
+	f.len++
+}
+
+func (f *freeList[E]) fork() nodeAllocator[E] {
+	return &freeList[E]{max: f.max}
+}
+
+type poolAllocator[E comparable] struct {
+	pool *sync.Pool
+}
+
+func (p *poolAllocator[E]) alloc() *node[E] {
+	return p.pool.Get().(*node[E])
+}
+
+func (p *poolAllocator[E]) free(n *node[E]) {
+	p.pool.Put(n)
+}
+
+func (p *poolAllocator[E]) fork() nodeAllocator[E] {
+	return p
+}
+
+type arenaAllocator[E comparable] struct {
+	block    []node[E]
+	size     int
+	released *node[E]
+}
+
+func (a *arenaAllocator[E]) alloc() *node[E] {
+	if a.released != nil {
+		n := a.released
+		a.released = n.next
+		return n
+	}
+	if len(a.block) == 0 {
+		a.block = make([]node[E], a.size)
+	}
+	n := &a.block[0]
+	a.block = a.block[1:]
+	return n
+}
+
+func (a *arenaAllocator[E]) free(n *node[E]) {
+	n.next = a.released
+	a.released = n
+}
+
+func (a *arenaAllocator[E]) fork() nodeAllocator[E] {
+	return &arenaAllocator[E]{size: a.size}
+}
@@ -0,0 +1,147 @@
+package ds
+
+import "testing"
+
+func TestCSLListAllocators(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ListOption[int]
+	}{
+		{
+			name: "heap",
+			opts: nil,
+		},
+		{
+			name: "free list",
+			opts: []ListOption[int]{WithFreeList[int](2)},
+		},
+		{
+			name: "node pool",
+			opts: []ListOption[int]{WithNodePool[int]()},
+		},
+		{
+			name: "arena",
+			opts: []ListOption[int]{WithArena[int](3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewCSLListWith(tt.opts...)
+			check := func(step, want string) {
+				t.Helper()
+				if got := l.String(); got != want {
+					t.Errorf("after %s = %v, want %v", step, got, want)
+				}
+			}
+
+			for i := range 5 {
+				l.Append(i)
+			}
+			check("Append()", "cslList{ 0 1 2 3 4 }")
+
+			l.Delete(4)
+			l.Delete(0)
+			l.Insert(9, 1)
+			check("Delete() and Insert()", "cslList{ 1 9 2 3 }")
+
+			l.Append(9)
+			l.DeleteAll(9)
+			l.Append(5)
+			check("DeleteAll()", "cslList{ 1 2 3 5 }")
+
+			c := l.Clone()
+			c.Delete(0)
+			c.Append(6)
+			check("modifying clone", "cslList{ 1 2 3 5 }")
+			if got := c.String(); got != "cslList{ 2 3 5 6 }" {
+				t.Errorf("clone = %v, want cslList{ 2 3 5 6 }", got)
+			}
+
+			l.Extend(c)
+			c.Clear()
+			l.Reverse()
+			check("Extend() and Reverse()", "cslList{ 6 5 3 2 5 3 2 1 }")
+
+			l.Clear()
+			check("Clear()", "cslList{  }")
+			l.Extend(NewCSLList(7, 8))
+			l.Append(9)
+			check("reuse after Clear()", "cslList{ 7 8 9 }")
+		})
+	}
+}
+
+func TestCSLListFreeListReuse(t *testing.T) {
+	l := NewCSLListWith(WithFreeList[int](1))
+	l.Append(1)
+	l.Append(2)
+	l.Append(3)
+
+	first := l.tail.next
+	l.Delete(0)
+	l.Delete(0)
+
+	if got := l.alloc.(*freeList[int]).len; got != 1 {
+		t.Fatalf("free list length = %d, want 1", got)
+	}
+
+	l.Insert(0, 0)
+	if l.tail.next != first {
+		t.Errorf("Insert() allocated a new node, want the first released one reused")
+	}
+	if got := l.String(); got != "cslList{ 0 3 }" {
+		t.Errorf("list after reuse = %v, want cslList{ 0 3 }", got)
+	}
+	if got := l.alloc.(*freeList[int]).len; got != 0 {
+		t.Errorf("free list length after reuse = %d, want 0", got)
+	}
+}
+
+func benchmarkCSLListChurn(b *testing.B, opts ...ListOption[int]) {
+	l := NewCSLListWith(opts...)
+	b.ReportAllocs()
+	for b.Loop() {
+		for i := range 64 {
+			l.Append(i)
+		}
+		for range 64 {
+			l.Delete(0)
+		}
+	}
+}
+
+func BenchmarkCSLListChurnHeap(b *testing.B) {
+	benchmarkCSLListChurn(b)
+}
+
+func BenchmarkCSLListChurnFreeList(b *testing.B) {
+	benchmarkCSLListChurn(b, WithFreeList[int](64))
+}
+
+func BenchmarkCSLListChurnPool(b *testing.B) {
+	benchmarkCSLListChurn(b, WithNodePool[int]())
+}
+
+func BenchmarkCSLListChurnArena(b *testing.B) {
+	benchmarkCSLListChurn(b, WithArena[int](64))
+}
+
+func benchmarkCSLListClone(b *testing.B, opts ...ListOption[int]) {
+	l := NewCSLListWith(opts...)
+	for i := range 256 {
+		l.Append(i)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		l.Clone()
+	}
+}
+
+func BenchmarkCSLListCloneHeap(b *testing.B) {
+	benchmarkCSLListClone(b)
+}
+
+func BenchmarkCSLListCloneArena(b *testing.B) {
+	benchmarkCSLListClone(b, WithArena[int](256))
+}
@@ -0,0 +1,42 @@
+package ds
+
+import "iter"
+
+// Queue pushes to the tail and pops from the head of a cslList, both of
+// which are O(1).
+type Queue[E comparable] struct {
+	l *cslList[E]
+}
+
+func NewQueue[E comparable](vs ...E) *Queue[E] {
+	return &Queue[E]{NewCSLList(vs...)}
+}
+
+func (q *Queue[E]) String() string {
+	return formatList("Queue", q.l.len, q.l.Iter())
+}
+
+func (q *Queue[E]) Len() int {
+	return q.l.len
+}
+
+func (q *Queue[E]) Push(v E) {
+	q.l.Append(v)
+}
+
+func (q *Queue[E]) Pop() (E, bool) {
+	if q.l.len == 0 {
+		var zero E
+		return zero, false
+	}
+	return q.l.MustDelete(0), true
+}
+
+func (q *Queue[E]) Peek() (E, bool) {
+	return q.l.At(0)
+}
+
+// Iter yields the elements from the front to the back of the queue.
+func (q *Queue[E]) Iter() iter.Seq2[int, E] {
+	return q.l.Iter()
+}
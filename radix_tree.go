@@ -0,0 +1,230 @@
+package ds
+
+import (
+	"iter"
+	"slices"
+	"unicode/utf8"
+)
+
+type radixNode[V any] struct {
+	// prefix labels the edge from the parent and is empty only at the root
+	prefix   []rune
+	children []*radixNode[V]
+	value    V
+	ok       bool
+}
+
+// child returns the index of the child whose prefix starts with r, or where
+// such a child would be inserted.
+func (n *radixNode[V]) child(r rune) (int, bool) {
+	return slices.BinarySearchFunc(n.children, r, func(c *radixNode[V], r rune) int {
+		return int(c.prefix[0]) - int(r)
+	})
+}
+
+// mergeChild folds the only child of a node without a value into it.
+func (n *radixNode[V]) mergeChild() {
+	c := n.children[0]
+	n.prefix = slices.Concat(n.prefix, c.prefix)
+	n.children = c.children
+	n.value, n.ok = c.value, c.ok
+}
+
+// RadixTree is a Trie whose chains of nodes with a single child and no value
+// are merged into one node labelled with all of their runes.
+type RadixTree[V any] struct {
+	root radixNode[V]
+	len  int
+}
+
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{}
+}
+
+func (t *RadixTree[V]) Len() int {
+	return t.len
+}
+
+func commonPrefix(a, b []rune) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// Insert sets the value of key and reports whether key is new.
+func (t *RadixTree[V]) Insert(key string, v V) bool {
+	rs := []rune(key)
+	n := &t.root
+	for len(rs) > 0 {
+		i, found := n.child(rs[0])
+		if !found {
+			n.children = slices.Insert(n.children, i, &radixNode[V]{prefix: rs, value: v, ok: true})
+			t.len++
+			return true
+		}
+		c := n.children[i]
+		common := commonPrefix(c.prefix, rs)
+		if common < len(c.prefix) {
+			mid := &radixNode[V]{prefix: c.prefix[:common:common], children: []*radixNode[V]{c}}
+			c.prefix = c.prefix[common:]
+			n.children[i] = mid
+			c = mid
+		}
+		n, rs = c, rs[common:]
+	}
+	n.value = v
+	if n.ok {
+		return false
+	}
+	n.ok = true
+	t.len++
+	return true
+}
+
+func (t *RadixTree[V]) find(key string) *radixNode[V] {
+	rs := []rune(key)
+	n := &t.root
+	for len(rs) > 0 {
+		i, found := n.child(rs[0])
+		if !found {
+			return nil
+		}
+		c := n.children[i]
+		if commonPrefix(c.prefix, rs) < len(c.prefix) {
+			return nil
+		}
+		n, rs = c, rs[len(c.prefix):]
+	}
+	return n
+}
+
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	n := t.find(key)
+	if n == nil || !n.ok {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Delete removes key and merges the nodes it leaves with a single child.
+func (t *RadixTree[V]) Delete(key string) bool {
+	rs := []rune(key)
+	var parent *radixNode[V]
+	n := &t.root
+	idx := 0
+	for len(rs) > 0 {
+		i, found := n.child(rs[0])
+		if !found {
+			return false
+		}
+		c := n.children[i]
+		if commonPrefix(c.prefix, rs) < len(c.prefix) {
+			return false
+		}
+		parent, n, idx = n, c, i
+		rs = rs[len(c.prefix):]
+	}
+	if !n.ok {
+		return false
+	}
+	var zero V
+	n.value, n.ok = zero, false
+	t.len--
+
+	if parent == nil {
+		return true
+	}
+	switch len(n.children) {
+	case 0:
+		parent.children = slices.Delete(parent.children, idx, idx+1)
+		if parent != &t.root && !parent.ok && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+	return true
+}
+
+// LongestPrefix returns the longest key that is a prefix of s.
+func (t *RadixTree[V]) LongestPrefix(s string) (string, V, bool) {
+	var found *radixNode[V]
+	end := 0
+	n := &t.root
+	if n.ok {
+		found = n
+	}
+	i := 0
+	for i < len(s) {
+		r, _ := utf8.DecodeRuneInString(s[i:])
+		ci, ok := n.child(r)
+		if !ok {
+			break
+		}
+		c := n.children[ci]
+		j := i
+		for _, pr := range c.prefix {
+			r, size := utf8.DecodeRuneInString(s[j:])
+			if j == len(s) || r != pr {
+				return t.longest(s, found, end)
+			}
+			j += size
+		}
+		n, i = c, j
+		if n.ok {
+			found, end = n, i
+		}
+	}
+	return t.longest(s, found, end)
+}
+
+func (t *RadixTree[V]) longest(s string, found *radixNode[V], end int) (string, V, bool) {
+	if found == nil {
+		var zero V
+		return "", zero, false
+	}
+	return s[:end], found.value, true
+}
+
+// WalkPrefix yields the keys starting with prefix and their values in
+// lexicographic order of runes.
+func (t *RadixTree[V]) WalkPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		rs := []rune(prefix)
+		key := []rune{}
+		n := &t.root
+		for len(rs) > 0 {
+			i, found := n.child(rs[0])
+			if !found {
+				return
+			}
+			c := n.children[i]
+			common := commonPrefix(c.prefix, rs)
+			if common < len(rs) && common < len(c.prefix) {
+				return
+			}
+			key = append(key, c.prefix...)
+			n, rs = c, rs[common:]
+		}
+		n.walk(key, yield)
+	}
+}
+
+func (t *RadixTree[V]) All() iter.Seq2[string, V] {
+	return t.WalkPrefix("")
+}
+
+func (n *radixNode[V]) walk(key []rune, yield func(string, V) bool) bool {
+	if n.ok && !yield(string(key), n.value) {
+		return false
+	}
+	for _, c := range n.children {
+		if !c.walk(append(key, c.prefix...), yield) {
+			return false
+		}
+	}
+	return true
+}
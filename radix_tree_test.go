@@ -0,0 +1,66 @@
+package ds
+
+import "testing"
+
+func TestRadixTreeInsertGetDelete(t *testing.T) {
+	testPrefixTreeInsertGetDelete(t, NewRadixTree[int])
+}
+
+func TestRadixTreeLongestPrefix(t *testing.T) {
+	testPrefixTreeLongestPrefix(t, NewRadixTree[int])
+}
+
+func TestRadixTreeWalkPrefix(t *testing.T) {
+	testPrefixTreeWalkPrefix(t, NewRadixTree[int])
+}
+
+func TestRadixTreeModel(t *testing.T) {
+	testPrefixTreeModel(t, NewRadixTree[int])
+}
+
+// countRadixNodes counts the nodes below the root and fails if any of them
+// could be merged with its only child.
+func countRadixNodes[V any](t *testing.T, n *radixNode[V], root bool) int {
+	t.Helper()
+	if !root && !n.ok && len(n.children) < 2 {
+		t.Fatalf("node %q without a value has %d children", string(n.prefix), len(n.children))
+	}
+	count := 0
+	for _, c := range n.children {
+		count += 1 + countRadixNodes(t, c, false)
+	}
+	return count
+}
+
+func TestRadixTreeCompression(t *testing.T) {
+	tests := []struct {
+		name      string
+		init      []string
+		delete    []string
+		wantNodes int
+	}{
+		{"single key", []string{"/static/"}, nil, 1},
+		{"split on shared prefix", []string{"/api/users", "/api/user"}, nil, 2},
+		{"split with inner node", []string{"/api/users", "/apps"}, nil, 3},
+		{"merge after deleting a leaf", []string{"/api/users", "/apps"}, []string{"/apps"}, 1},
+		{"merge after deleting an inner key", []string{"/api", "/api/users"}, []string{"/api"}, 1},
+		{"unicode edges", []string{"日本", "日本語", "日曜"}, nil, 4},
+		{"routes", routeKeys, nil, 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newTestPrefixTree(NewRadixTree[int], tt.init...)
+			for _, k := range tt.delete {
+				tr.Delete(k)
+			}
+			if got := countRadixNodes(t, &tr.root, true); got != tt.wantNodes {
+				t.Errorf("tree has %d nodes, want %d", got, tt.wantNodes)
+			}
+		})
+	}
+}
+
+func BenchmarkRadixTreeGet(b *testing.B) {
+	benchmarkPrefixTreeGet(b, NewRadixTree[int])
+}
@@ -0,0 +1,120 @@
+package ds
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+var ErrBufferFull = errors.New("buffer is full")
+
+type RingMode int
+
+const (
+	// Overwrite makes Push drop the oldest element of a full buffer.
+	Overwrite RingMode = iota
+	// Reject makes Push fail with ErrBufferFull on a full buffer.
+	Reject
+)
+
+type RingBuffer[E any] struct {
+	buf  []E
+	head int
+	len  int
+	mode RingMode
+}
+
+// NewRingBuffer returns a buffer of the given capacity, raised to at least 0.
+func NewRingBuffer[E any](capacity int, mode RingMode) *RingBuffer[E] {
+	return &RingBuffer[E]{buf: make([]E, max(capacity, 0)), mode: mode}
+}
+
+func (r *RingBuffer[E]) String() string {
+	return formatList("RingBuffer", r.len, r.Iter())
+}
+
+func (r *RingBuffer[E]) Len() int {
+	return r.len
+}
+
+func (r *RingBuffer[E]) Cap() int {
+	return len(r.buf)
+}
+
+func (r *RingBuffer[E]) Full() bool {
+	return r.len == len(r.buf)
+}
+
+func (r *RingBuffer[E]) Push(v E) error {
+	if r.Full() {
+		if r.mode == Reject {
+			return fmt.Errorf("%w with capacity %d", ErrBufferFull, len(r.buf))
+		}
+		if len(r.buf) == 0 {
+			return nil
+		}
+		r.buf[r.head] = v
+		r.head = (r.head + 1) % len(r.buf)
+		return nil
+	}
+	r.buf[(r.head+r.len)%len(r.buf)] = v
+	r.len++
+	return nil
+}
+
+// Pop removes and returns the oldest element.
+func (r *RingBuffer[E]) Pop() (E, bool) {
+	var zero E
+	if r.len == 0 {
+		return zero, false
+	}
+	v := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.len--
+	return v, true
+}
+
+// Peek returns the oldest element.
+func (r *RingBuffer[E]) Peek() (E, bool) {
+	if r.len == 0 {
+		var zero E
+		return zero, false
+	}
+	return r.buf[r.head], true
+}
+
+// Get returns the i-th element counting from the oldest one.
+func (r *RingBuffer[E]) Get(i int) (E, error) {
+	var zero E
+	if i < 0 {
+		return zero, fmt.Errorf("%w [%d]", ErrIndexOutOfRange, i)
+	}
+	if i >= r.len {
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, r.len)
+	}
+	return r.buf[(r.head+i)%len(r.buf)], nil
+}
+
+// At is Get reporting an index out of range with false.
+func (r *RingBuffer[E]) At(i int) (E, bool) {
+	v, err := r.Get(i)
+	return v, err == nil
+}
+
+func (r *RingBuffer[E]) Clear() {
+	clear(r.buf)
+	r.head = 0
+	r.len = 0
+}
+
+// Iter yields the elements from the oldest to the newest.
+func (r *RingBuffer[E]) Iter() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		for i := range r.len {
+			if !yield(i, r.buf[(r.head+i)%len(r.buf)]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package ds
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestRingBufferPush(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		mode     RingMode
+		push     []int
+		want     string
+		wantFull bool
+		wantErr  error
+	}{
+		{
+			name:     "empty",
+			capacity: 3,
+			mode:     Overwrite,
+			want:     "RingBuffer{  }",
+		},
+		{
+			name:     "not full",
+			capacity: 3,
+			mode:     Reject,
+			push:     []int{1, 2},
+			want:     "RingBuffer{ 1 2 }",
+		},
+		{
+			name:     "exactly full",
+			capacity: 3,
+			mode:     Reject,
+			push:     []int{1, 2, 3},
+			want:     "RingBuffer{ 1 2 3 }",
+			wantFull: true,
+		},
+		{
+			name:     "overwrite oldest",
+			capacity: 3,
+			mode:     Overwrite,
+			push:     []int{1, 2, 3, 4, 5},
+			want:     "RingBuffer{ 3 4 5 }",
+			wantFull: true,
+		},
+		{
+			name:     "reject when full",
+			capacity: 3,
+			mode:     Reject,
+			push:     []int{1, 2, 3, 4, 5},
+			want:     "RingBuffer{ 1 2 3 }",
+			wantFull: true,
+			wantErr:  ErrBufferFull,
+		},
+		{
+			name:     "zero capacity overwrite",
+			capacity: 0,
+			mode:     Overwrite,
+			push:     []int{1},
+			want:     "RingBuffer{  }",
+			wantFull: true,
+		},
+		{
+			name:     "zero capacity reject",
+			capacity: 0,
+			mode:     Reject,
+			push:     []int{1},
+			want:     "RingBuffer{  }",
+			wantFull: true,
+			wantErr:  ErrBufferFull,
+		},
+		{
+			name:     "negative capacity",
+			capacity: -2,
+			mode:     Reject,
+			push:     []int{1},
+			want:     "RingBuffer{  }",
+			wantFull: true,
+			wantErr:  ErrBufferFull,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRingBuffer[int](tt.capacity, tt.mode)
+			var err error
+			for _, v := range tt.push {
+				if e := r.Push(v); e != nil {
+					err = e
+				}
+			}
+
+			if got := r.String(); got != tt.want {
+				t.Errorf("buffer after Push() = %v, want %v", got, tt.want)
+			}
+
+			if got := r.Full(); got != tt.wantFull {
+				t.Errorf("Full() = %v, want %v", got, tt.wantFull)
+			}
+
+			if got := r.Cap(); got != max(tt.capacity, 0) {
+				t.Errorf("Cap() = %v, want %v", got, max(tt.capacity, 0))
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Push() error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected Push() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestRingBufferPop(t *testing.T) {
+	tests := []struct {
+		name     string
+		push     []int
+		pops     int
+		wantVals []int
+		wantOks  []bool
+		wantPeek int
+		want     string
+	}{
+		{
+			name:     "empty",
+			pops:     1,
+			wantVals: []int{0},
+			wantOks:  []bool{false},
+			want:     "RingBuffer{  }",
+		},
+		{
+			name:     "fifo order",
+			push:     []int{1, 2, 3},
+			pops:     2,
+			wantVals: []int{1, 2},
+			wantOks:  []bool{true, true},
+			wantPeek: 3,
+			want:     "RingBuffer{ 3 }",
+		},
+		{
+			name:     "after wrap around",
+			push:     []int{1, 2, 3, 4, 5, 6, 7},
+			pops:     2,
+			wantVals: []int{5, 6},
+			wantOks:  []bool{true, true},
+			wantPeek: 7,
+			want:     "RingBuffer{ 7 }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRingBuffer[int](3, Overwrite)
+			for _, v := range tt.push {
+				r.Push(v)
+			}
+
+			var gotVals []int
+			var gotOks []bool
+			for range tt.pops {
+				v, ok := r.Pop()
+				gotVals = append(gotVals, v)
+				gotOks = append(gotOks, ok)
+			}
+
+			if !slices.Equal(gotVals, tt.wantVals) || !slices.Equal(gotOks, tt.wantOks) {
+				t.Errorf("Pop() returned %v %v, want %v %v", gotVals, gotOks, tt.wantVals, tt.wantOks)
+			}
+
+			if got, _ := r.Peek(); got != tt.wantPeek {
+				t.Errorf("Peek() = %v, want %v", got, tt.wantPeek)
+			}
+
+			if got := r.String(); got != tt.want {
+				t.Errorf("buffer after Pop() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRingBufferGet(t *testing.T) {
+	tests := []struct {
+		name    string
+		push    []int
+		index   int
+		wantVal int
+		wantErr error
+	}{
+		{
+			name:    "oldest",
+			push:    []int{1, 2, 3, 4},
+			index:   0,
+			wantVal: 2,
+		},
+		{
+			name:    "newest",
+			push:    []int{1, 2, 3, 4},
+			index:   2,
+			wantVal: 4,
+		},
+		{
+			name:    "negative index",
+			push:    []int{1, 2, 3},
+			index:   -1,
+			wantErr: ErrIndexOutOfRange,
+		},
+		{
+			name:    "index equals length",
+			push:    []int{1, 2},
+			index:   2,
+			wantErr: ErrIndexOutOfRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRingBuffer[int](3, Overwrite)
+			for _, v := range tt.push {
+				r.Push(v)
+			}
+
+			gotVal, err := r.Get(tt.index)
+			if gotVal != tt.wantVal {
+				t.Errorf("Get(%d) = %v, want %v", tt.index, gotVal, tt.wantVal)
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Get() error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected Get() error = %v", err)
+			}
+
+			if v, ok := r.At(tt.index); v != tt.wantVal || ok != (tt.wantErr == nil) {
+				t.Errorf("At(%d) = %v, %v, want %v, %v", tt.index, v, ok, tt.wantVal, tt.wantErr == nil)
+			}
+		})
+	}
+
+	t.Run("clear", func(t *testing.T) {
+		r := NewRingBuffer[int](2, Overwrite)
+		r.Push(1)
+		r.Push(2)
+		r.Push(3)
+		r.Clear()
+		r.Push(4)
+		if got := r.String(); got != "RingBuffer{ 4 }" {
+			t.Errorf("buffer after Clear() and Push() = %v, want RingBuffer{ 4 }", got)
+		}
+	})
+}
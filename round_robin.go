@@ -0,0 +1,149 @@
+package ds
+
+import "iter"
+
+type rrEntry[E comparable] struct {
+	value   E
+	weight  int
+	current int
+	down    bool
+	removed bool
+}
+
+// RoundRobin picks elements of a ring in turn using the smooth weighted
+// round-robin of nginx: an element of weight w is picked w times per round,
+// and picks of heavy elements are spread over the round instead of coming in
+// a row. Elements with equal weights are picked in the order they were added.
+type RoundRobin[E comparable] struct {
+	l *cslList[*rrEntry[E]]
+}
+
+// NewRoundRobin adds vs with a weight of 1.
+func NewRoundRobin[E comparable](vs ...E) *RoundRobin[E] {
+	r := &RoundRobin[E]{NewCSLList[*rrEntry[E]]()}
+	for _, v := range vs {
+		r.Add(v, 1)
+	}
+	return r
+}
+
+func (r *RoundRobin[E]) String() string {
+	return formatList("RoundRobin", r.l.len, r.values())
+}
+
+func (r *RoundRobin[E]) values() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		for i, e := range r.l.Iter() {
+			if !yield(i, e.value) {
+				return
+			}
+		}
+	}
+}
+
+func (r *RoundRobin[E]) Len() int {
+	return r.l.len
+}
+
+func (r *RoundRobin[E]) find(v E) *rrEntry[E] {
+	for _, e := range r.l.Iter() {
+		if e.value == v {
+			return e
+		}
+	}
+	return nil
+}
+
+// Add appends v with the given weight, raised to at least 1, and reports
+// whether v was not there yet. The weight of an existing element is not
+// changed.
+func (r *RoundRobin[E]) Add(v E, weight int) bool {
+	if r.find(v) != nil {
+		return false
+	}
+	r.l.Append(&rrEntry[E]{value: v, weight: max(weight, 1)})
+	return true
+}
+
+func (r *RoundRobin[E]) Remove(v E) bool {
+	e := r.find(v)
+	if e == nil {
+		return false
+	}
+	r.l.DeleteAll(e)
+	e.removed = true
+	return true
+}
+
+func (r *RoundRobin[E]) SetWeight(v E, weight int) bool {
+	e := r.find(v)
+	if e == nil {
+		return false
+	}
+	e.weight = max(weight, 1)
+	return true
+}
+
+// MarkDown makes Next skip v until it is marked up again.
+func (r *RoundRobin[E]) MarkDown(v E) bool {
+	e := r.find(v)
+	if e == nil {
+		return false
+	}
+	e.down = true
+	e.current = 0
+	return true
+}
+
+func (r *RoundRobin[E]) MarkUp(v E) bool {
+	e := r.find(v)
+	if e == nil {
+		return false
+	}
+	e.down = false
+	return true
+}
+
+func (r *RoundRobin[E]) Healthy(v E) bool {
+	e := r.find(v)
+	return e != nil && !e.down
+}
+
+// Next returns the next healthy element, or false if there is none.
+func (r *RoundRobin[E]) Next() (E, bool) {
+	var best *rrEntry[E]
+	total := 0
+	for _, e := range r.l.Iter() {
+		if e.down {
+			continue
+		}
+		e.current += e.weight
+		total += e.weight
+		if best == nil || e.current > best.current {
+			best = e
+		}
+	}
+	if best == nil {
+		var zero E
+		return zero, false
+	}
+	best.current -= total
+	return best.value, true
+}
+
+// All yields the elements with their weights in the order they were added.
+// The elements may be added and removed during the iteration: it yields the
+// elements there when it started that have not been removed since.
+func (r *RoundRobin[E]) All() iter.Seq2[E, int] {
+	return func(yield func(E, int) bool) {
+		entries := make([]*rrEntry[E], 0, r.l.len)
+		for _, e := range r.l.Iter() {
+			entries = append(entries, e)
+		}
+		for _, e := range entries {
+			if !e.removed && !yield(e.value, e.weight) {
+				return
+			}
+		}
+	}
+}
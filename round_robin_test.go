@@ -0,0 +1,192 @@
+package ds
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func nextN(r *RoundRobin[string], n int) string {
+	picks := make([]string, 0, n)
+	for range n {
+		v, ok := r.Next()
+		if !ok {
+			v = "-"
+		}
+		picks = append(picks, v)
+	}
+	return strings.Join(picks, "")
+}
+
+func TestRoundRobinNext(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights map[string]int
+		order   []string
+		op      func(r *RoundRobin[string])
+		n       int
+		want    string
+	}{
+		{
+			name: "empty",
+			n:    2,
+			want: "--",
+		},
+		{
+			name:  "equal weights rotate",
+			order: []string{"a", "b", "c"},
+			n:     7,
+			want:  "abcabca",
+		},
+		{
+			name:    "nginx example",
+			weights: map[string]int{"a": 5, "b": 1, "c": 1},
+			order:   []string{"a", "b", "c"},
+			n:       14,
+			want:    "aabacaaaabacaa",
+		},
+		{
+			name:    "heavy element spread over the round",
+			weights: map[string]int{"a": 3, "b": 2},
+			order:   []string{"a", "b"},
+			n:       10,
+			want:    "ababaababa",
+		},
+		{
+			name:  "down elements are skipped",
+			order: []string{"a", "b", "c"},
+			op:    func(r *RoundRobin[string]) { r.MarkDown("b") },
+			n:     4,
+			want:  "acac",
+		},
+		{
+			name:  "all down",
+			order: []string{"a", "b"},
+			op: func(r *RoundRobin[string]) {
+				r.MarkDown("a")
+				r.MarkDown("b")
+			},
+			n:    2,
+			want: "--",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRoundRobin[string]()
+			for _, v := range tt.order {
+				w, ok := tt.weights[v]
+				if !ok {
+					w = 1
+				}
+				r.Add(v, w)
+			}
+			if tt.op != nil {
+				tt.op(r)
+			}
+			if got := nextN(r, tt.n); got != tt.want {
+				t.Errorf("picks = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundRobinChanges(t *testing.T) {
+	r := NewRoundRobin("a", "b", "c")
+	if got := nextN(r, 2); got != "ab" {
+		t.Fatalf("picks = %s, want ab", got)
+	}
+
+	if !r.Remove("c") || r.Remove("c") {
+		t.Error("Remove(c) twice, want true then false")
+	}
+	if !r.Add("d", 2) || r.Add("a", 5) {
+		t.Error("Add(d) then Add(a), want true then false")
+	}
+	if got := r.String(); got != "RoundRobin{ a b d }" {
+		t.Errorf("String() = %s, want RoundRobin{ a b d }", got)
+	}
+	if got := nextN(r, 8); got != "dabddabd" {
+		t.Errorf("picks after changes = %s, want dabddabd", got)
+	}
+
+	r.MarkDown("d")
+	if r.Healthy("d") || !r.Healthy("a") || r.Healthy("x") {
+		t.Error("Healthy() after MarkDown(d), want only a and b healthy")
+	}
+	if got := nextN(r, 4); got != "abab" {
+		t.Errorf("picks with d down = %s, want abab", got)
+	}
+	r.MarkUp("d")
+	r.SetWeight("a", 3)
+	if got := nextN(r, 6); got != "adabda" {
+		t.Errorf("picks after MarkUp(d) and SetWeight(a, 3) = %s, want adabda", got)
+	}
+
+	want := map[string]int{"a": 3, "b": 1, "d": 2}
+	if got := maps.Collect(r.All()); !maps.Equal(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+	if r.SetWeight("x", 1) || r.MarkDown("x") || r.MarkUp("x") {
+		t.Error("changing a missing element succeeded")
+	}
+}
+
+func TestRoundRobinChangesDuringAll(t *testing.T) {
+	r := NewRoundRobin("a", "b", "c")
+	var got []string
+	for v := range r.All() {
+		got = append(got, v)
+		if v == "a" {
+			r.Remove("b")
+			r.Add("d", 1)
+		}
+	}
+	if !slices.Equal(got, []string{"a", "c"}) {
+		t.Errorf("All() while removing b and adding d = %v, want [a c]", got)
+	}
+
+	got = nil
+	for v := range r.All() {
+		got = append(got, v)
+		r.Remove(v)
+		r.Add(v+v, 1)
+	}
+	if !slices.Equal(got, []string{"a", "c", "d"}) {
+		t.Errorf("All() while replacing every element = %v, want [a c d]", got)
+	}
+	if s := r.String(); s != "RoundRobin{ aa cc dd }" {
+		t.Errorf("String() = %s, want RoundRobin{ aa cc dd }", s)
+	}
+}
+
+func TestRoundRobinShares(t *testing.T) {
+	r := NewRoundRobin[string]()
+	weights := map[string]int{"a": 7, "b": 3, "c": 1, "d": 1}
+	for _, v := range slices.Sorted(maps.Keys(weights)) {
+		r.Add(v, weights[v])
+	}
+
+	// every round of 12 picks hands out exactly the weights
+	for round := range 5 {
+		picks := map[string]int{}
+		for range 12 {
+			v, _ := r.Next()
+			picks[v]++
+		}
+		if !maps.Equal(picks, weights) {
+			t.Fatalf("round %d: picks = %v, want %v", round, picks, weights)
+		}
+	}
+}
+
+func BenchmarkRoundRobinNext(b *testing.B) {
+	r := NewRoundRobin[int]()
+	for i := range 16 {
+		r.Add(i, i%4+1)
+	}
+	for b.Loop() {
+		r.Next()
+	}
+}
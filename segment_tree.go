@@ -0,0 +1,216 @@
+package ds
+
+import "fmt"
+
+// SegmentTree answers queries over ranges of a sequence of T combined with
+// an associative function, and applies updates of type U to whole ranges
+// lazily. Queries and updates take O(log n).
+type SegmentTree[T, U any] struct {
+	n        int
+	identity T
+	combine  func(a, b T) T
+	apply    func(v T, u U, n int) T
+	compose  func(u, next U) U
+
+	tree    []T
+	lazy    []U
+	pending []bool
+}
+
+// NewSegmentTree builds a tree holding vs in O(n). identity must be
+// neutral to combine. apply returns the combined value of a range of n
+// elements after u is applied to each of them, and compose returns the
+// update equal to u followed by next. apply and compose may be nil if
+// Update is never called.
+func NewSegmentTree[T, U any](
+	vs []T,
+	identity T,
+	combine func(a, b T) T,
+	apply func(v T, u U, n int) T,
+	compose func(u, next U) U,
+) *SegmentTree[T, U] {
+	size := 1
+	for size < len(vs) {
+		size *= 2
+	}
+	s := &SegmentTree[T, U]{
+		n:        len(vs),
+		identity: identity,
+		combine:  combine,
+		apply:    apply,
+		compose:  compose,
+		tree:     make([]T, 2*size),
+		lazy:     make([]U, 2*size),
+		pending:  make([]bool, 2*size),
+	}
+	if s.n > 0 {
+		s.build(1, 0, s.n, vs)
+	}
+	return s
+}
+
+func plus[T Number](a, b T) T { return a + b }
+
+// NewSumSegmentTree returns a tree of range sums whose updates add a value
+// to every element of a range.
+func NewSumSegmentTree[T Number](vs []T) *SegmentTree[T, T] {
+	return NewSegmentTree(vs, 0, plus[T],
+		func(v, u T, n int) T { return v + u*T(n) }, plus[T])
+}
+
+// NewMinSegmentTree returns a tree of range minimums whose updates add a
+// value to every element of a range. identity must not be less than any
+// element, such as math.MaxInt or math.Inf(1).
+func NewMinSegmentTree[T Number](vs []T, identity T) *SegmentTree[T, T] {
+	return NewSegmentTree(vs, identity, func(a, b T) T { return min(a, b) },
+		func(v, u T, _ int) T { return v + u }, plus[T])
+}
+
+// NewMaxSegmentTree returns a tree of range maximums whose updates add a
+// value to every element of a range. identity must not be greater than any
+// element, such as math.MinInt or math.Inf(-1).
+func NewMaxSegmentTree[T Number](vs []T, identity T) *SegmentTree[T, T] {
+	return NewSegmentTree(vs, identity, func(a, b T) T { return max(a, b) },
+		func(v, u T, _ int) T { return v + u }, plus[T])
+}
+
+func (s *SegmentTree[T, U]) build(node, lo, hi int, vs []T) {
+	if hi-lo == 1 {
+		s.tree[node] = vs[lo]
+		return
+	}
+	mid := (lo + hi) / 2
+	s.build(2*node, lo, mid, vs)
+	s.build(2*node+1, mid, hi, vs)
+	s.tree[node] = s.combine(s.tree[2*node], s.tree[2*node+1])
+}
+
+func (s *SegmentTree[T, U]) Len() int {
+	return s.n
+}
+
+func (s *SegmentTree[T, U]) checkRange(lo, hi int) error {
+	if lo < 0 || hi < lo || hi > s.n {
+		return fmt.Errorf("%w [%d:%d] with length %d", ErrListBounds, lo, hi, s.n)
+	}
+	return nil
+}
+
+func (s *SegmentTree[T, U]) checkIndex(i int) error {
+	if i < 0 || i >= s.n {
+		return fmt.Errorf("%w [%d] with length %d", ErrListBounds, i, s.n)
+	}
+	return nil
+}
+
+// applyTo applies u to the node covering n elements.
+func (s *SegmentTree[T, U]) applyTo(node int, u U, n int) {
+	s.tree[node] = s.apply(s.tree[node], u, n)
+	if n == 1 {
+		return
+	}
+	if s.pending[node] {
+		s.lazy[node] = s.compose(s.lazy[node], u)
+	} else {
+		s.lazy[node], s.pending[node] = u, true
+	}
+}
+
+// push hands the pending update of a node covering [lo, hi) to its children.
+func (s *SegmentTree[T, U]) push(node, lo, hi int) {
+	if !s.pending[node] {
+		return
+	}
+	mid := (lo + hi) / 2
+	s.applyTo(2*node, s.lazy[node], mid-lo)
+	s.applyTo(2*node+1, s.lazy[node], hi-mid)
+	var zero U
+	s.lazy[node], s.pending[node] = zero, false
+}
+
+// Query returns the combined value of the elements in [lo, hi), or the
+// identity for an empty range.
+func (s *SegmentTree[T, U]) Query(lo, hi int) (T, error) {
+	if err := s.checkRange(lo, hi); err != nil {
+		var zero T
+		return zero, err
+	}
+	if lo == hi {
+		return s.identity, nil
+	}
+	return s.query(1, 0, s.n, lo, hi), nil
+}
+
+func (s *SegmentTree[T, U]) query(node, nodeLo, nodeHi, lo, hi int) T {
+	if lo <= nodeLo && nodeHi <= hi {
+		return s.tree[node]
+	}
+	s.push(node, nodeLo, nodeHi)
+	mid := (nodeLo + nodeHi) / 2
+	switch {
+	case hi <= mid:
+		return s.query(2*node, nodeLo, mid, lo, hi)
+	case lo >= mid:
+		return s.query(2*node+1, mid, nodeHi, lo, hi)
+	}
+	return s.combine(s.query(2*node, nodeLo, mid, lo, hi), s.query(2*node+1, mid, nodeHi, lo, hi))
+}
+
+func (s *SegmentTree[T, U]) Get(i int) (T, error) {
+	if err := s.checkIndex(i); err != nil {
+		var zero T
+		return zero, err
+	}
+	return s.query(1, 0, s.n, i, i+1), nil
+}
+
+// Update applies u to every element in [lo, hi).
+func (s *SegmentTree[T, U]) Update(lo, hi int, u U) error {
+	if err := s.checkRange(lo, hi); err != nil {
+		return err
+	}
+	if lo < hi {
+		s.update(1, 0, s.n, lo, hi, u)
+	}
+	return nil
+}
+
+func (s *SegmentTree[T, U]) update(node, nodeLo, nodeHi, lo, hi int, u U) {
+	if lo <= nodeLo && nodeHi <= hi {
+		s.applyTo(node, u, nodeHi-nodeLo)
+		return
+	}
+	s.push(node, nodeLo, nodeHi)
+	mid := (nodeLo + nodeHi) / 2
+	if lo < mid {
+		s.update(2*node, nodeLo, mid, lo, hi, u)
+	}
+	if hi > mid {
+		s.update(2*node+1, mid, nodeHi, lo, hi, u)
+	}
+	s.tree[node] = s.combine(s.tree[2*node], s.tree[2*node+1])
+}
+
+// Set replaces the element at index i.
+func (s *SegmentTree[T, U]) Set(i int, v T) error {
+	if err := s.checkIndex(i); err != nil {
+		return err
+	}
+	s.set(1, 0, s.n, i, v)
+	return nil
+}
+
+func (s *SegmentTree[T, U]) set(node, lo, hi, i int, v T) {
+	if hi-lo == 1 {
+		s.tree[node] = v
+		return
+	}
+	s.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	if i < mid {
+		s.set(2*node, lo, mid, i, v)
+	} else {
+		s.set(2*node+1, mid, hi, i, v)
+	}
+	s.tree[node] = s.combine(s.tree[2*node], s.tree[2*node+1])
+}
@@ -0,0 +1,143 @@
+package ds
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestSegmentTree(t *testing.T) {
+	vs := []int{5, 2, 8, 1, 9, 3}
+	sum, lo, hi := NewSumSegmentTree(vs), NewMinSegmentTree(vs, math.MaxInt), NewMaxSegmentTree(vs, math.MinInt)
+
+	tests := []struct {
+		name          string
+		lo, hi        int
+		sum, min, max int
+	}{
+		{"all", 0, 6, 28, 1, 9},
+		{"one", 2, 3, 8, 8, 8},
+		{"middle", 1, 4, 11, 1, 8},
+		{"empty", 3, 3, 0, math.MaxInt, math.MinInt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, q := range []struct {
+				op   string
+				s    *SegmentTree[int, int]
+				want int
+			}{{"sum", sum, tt.sum}, {"min", lo, tt.min}, {"max", hi, tt.max}} {
+				if got, err := q.s.Query(tt.lo, tt.hi); err != nil || got != q.want {
+					t.Errorf("%s Query(%d, %d) = %d, %v, want %d", q.op, tt.lo, tt.hi, got, err, q.want)
+				}
+			}
+		})
+	}
+
+	sum.Update(1, 5, 10)
+	sum.Set(0, 0)
+	if got, _ := sum.Query(0, 6); got != 63 {
+		t.Errorf("Query(0, 6) after updates = %d, want 63", got)
+	}
+	if got, _ := sum.Get(3); got != 11 {
+		t.Errorf("Get(3) after updates = %d, want 11", got)
+	}
+
+	errs := map[string]error{
+		"Query(-1, 2)": func() error { _, err := sum.Query(-1, 2); return err }(),
+		"Query(4, 3)":  func() error { _, err := sum.Query(4, 3); return err }(),
+		"Query(0, 7)":  func() error { _, err := sum.Query(0, 7); return err }(),
+		"Get(6)":       func() error { _, err := sum.Get(6); return err }(),
+		"Set(-1)":      sum.Set(-1, 0),
+		"Update(2, 9)": sum.Update(2, 9, 1),
+	}
+	for name, err := range errs {
+		if !errors.Is(err, ErrListBounds) {
+			t.Errorf("%s returned %v, want ErrListBounds", name, err)
+		}
+	}
+
+	empty := NewSumSegmentTree[float64](nil)
+	if got, err := empty.Query(0, 0); err != nil || got != 0 || empty.Len() != 0 {
+		t.Errorf("Query(0, 0) of an empty tree = %v, %v", got, err)
+	}
+}
+
+// assignSum is a segment tree of range sums whose updates assign a value to
+// every element of a range.
+func assignSum(vs []int) *SegmentTree[int, int] {
+	return NewSegmentTree(vs, 0,
+		func(a, b int) int { return a + b },
+		func(_, u, n int) int { return u * n },
+		func(_, next int) int { return next })
+}
+
+func TestSegmentTreeModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(31, 32))
+	type tree struct {
+		name   string
+		new    func([]int) *SegmentTree[int, int]
+		update func(v, u int) int
+		fold   func(vs []int) int
+	}
+	sumOf := func(vs []int) int {
+		s := 0
+		for _, v := range vs {
+			s += v
+		}
+		return s
+	}
+	trees := []tree{
+		{"sum", NewSumSegmentTree[int], func(v, u int) int { return v + u }, sumOf},
+		{"min", func(vs []int) *SegmentTree[int, int] { return NewMinSegmentTree(vs, math.MaxInt) },
+			func(v, u int) int { return v + u }, slices.Min[[]int]},
+		{"assign sum", assignSum, func(_, u int) int { return u }, sumOf},
+	}
+
+	for _, tt := range trees {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, n := range []int{1, 3, 10, 33} {
+				model := make([]int, n)
+				for i := range model {
+					model[i] = r.IntN(50)
+				}
+				s := tt.new(model)
+
+				for step := range 300 {
+					lo := r.IntN(n + 1)
+					hi := lo + r.IntN(n-lo+1)
+					switch r.IntN(3) {
+					case 0:
+						u := r.IntN(21) - 10
+						s.Update(lo, hi, u)
+						for i := lo; i < hi; i++ {
+							model[i] = tt.update(model[i], u)
+						}
+					case 1:
+						i, v := r.IntN(n), r.IntN(50)
+						s.Set(i, v)
+						model[i] = v
+					}
+
+					lo = r.IntN(n)
+					hi = lo + 1 + r.IntN(n-lo)
+					if got, err := s.Query(lo, hi); err != nil || got != tt.fold(model[lo:hi]) {
+						t.Fatalf("n %d step %d: Query(%d, %d) = %d, %v, want %d", n, step, lo, hi, got, err, tt.fold(model[lo:hi]))
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkSegmentTreeUpdateQuery(b *testing.B) {
+	const n = 1 << 16
+	s := NewSumSegmentTree(make([]int, n))
+	for i := 0; b.Loop(); i++ {
+		lo := i % (n / 2)
+		s.Update(lo, lo+n/2, 1)
+		s.Query(n/4, 3*n/4)
+	}
+}
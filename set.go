@@ -0,0 +1,139 @@
+package ds
+
+import (
+	"iter"
+	"maps"
+)
+
+// Set is an unordered collection of distinct elements. The algebra methods
+// return new sets and leave their operands unchanged.
+type Set[E comparable] struct {
+	m map[E]struct{}
+}
+
+func NewSet[E comparable](vs ...E) *Set[E] {
+	s := &Set[E]{make(map[E]struct{}, len(vs))}
+	for _, v := range vs {
+		s.m[v] = struct{}{}
+	}
+	return s
+}
+
+// NewSetFromList collects the distinct elements of l.
+func NewSetFromList[E comparable](l List[E]) *Set[E] {
+	s := &Set[E]{make(map[E]struct{}, l.Length())}
+	for _, v := range l.Iter() {
+		s.m[v] = struct{}{}
+	}
+	return s
+}
+
+func (s *Set[E]) Len() int {
+	return len(s.m)
+}
+
+// Add reports whether v was not in the set yet.
+func (s *Set[E]) Add(v E) bool {
+	if _, ok := s.m[v]; ok {
+		return false
+	}
+	s.m[v] = struct{}{}
+	return true
+}
+
+func (s *Set[E]) Remove(v E) bool {
+	if _, ok := s.m[v]; !ok {
+		return false
+	}
+	delete(s.m, v)
+	return true
+}
+
+func (s *Set[E]) Contains(v E) bool {
+	_, ok := s.m[v]
+	return ok
+}
+
+func (s *Set[E]) Clear() {
+	clear(s.m)
+}
+
+func (s *Set[E]) Clone() *Set[E] {
+	return &Set[E]{maps.Clone(s.m)}
+}
+
+func (s *Set[E]) Union(o *Set[E]) *Set[E] {
+	u := s.Clone()
+	for v := range o.m {
+		u.m[v] = struct{}{}
+	}
+	return u
+}
+
+func (s *Set[E]) Intersect(o *Set[E]) *Set[E] {
+	small, large := s, o
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	i := NewSet[E]()
+	for v := range small.m {
+		if large.Contains(v) {
+			i.m[v] = struct{}{}
+		}
+	}
+	return i
+}
+
+// Difference returns the elements of s that are not in o.
+func (s *Set[E]) Difference(o *Set[E]) *Set[E] {
+	d := NewSet[E]()
+	for v := range s.m {
+		if !o.Contains(v) {
+			d.m[v] = struct{}{}
+		}
+	}
+	return d
+}
+
+// SymmetricDifference returns the elements that are in exactly one of s and
+// o.
+func (s *Set[E]) SymmetricDifference(o *Set[E]) *Set[E] {
+	d := s.Difference(o)
+	for v := range o.m {
+		if !s.Contains(v) {
+			d.m[v] = struct{}{}
+		}
+	}
+	return d
+}
+
+// IsSubset reports whether every element of s is in o.
+func (s *Set[E]) IsSubset(o *Set[E]) bool {
+	if s.Len() > o.Len() {
+		return false
+	}
+	for v := range s.m {
+		if !o.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Set[E]) Equal(o *Set[E]) bool {
+	return s.Len() == o.Len() && s.IsSubset(o)
+}
+
+// Iter yields the elements in no particular order.
+func (s *Set[E]) Iter() iter.Seq[E] {
+	return maps.Keys(s.m)
+}
+
+// ToList returns the elements in a cslList in no particular order.
+func (s *Set[E]) ToList() *cslList[E] {
+	l := NewCSLList[E]()
+	for v := range s.m {
+		l.Append(v)
+	}
+	return l
+}
@@ -0,0 +1,143 @@
+package ds
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSetAddRemove(t *testing.T) {
+	s := NewSet(3, 1, 3)
+	if got := s.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+	if !s.Add(2) || s.Add(2) {
+		t.Error("Add(2) twice, want true then false")
+	}
+	if !s.Remove(1) || s.Remove(1) {
+		t.Error("Remove(1) twice, want true then false")
+	}
+	if !s.Contains(3) || s.Contains(1) {
+		t.Error("Contains() after Remove(1), want 3 but not 1")
+	}
+	if got := slices.Sorted(s.Iter()); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Iter() = %v, want [2 3]", got)
+	}
+	s.Clear()
+	if s.Len() != 0 {
+		t.Errorf("Len() after Clear() = %d, want 0", s.Len())
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    []int
+		union   []int
+		inter   []int
+		diff    []int
+		symDiff []int
+		subset  bool
+	}{
+		{
+			name:   "both empty",
+			subset: true,
+		},
+		{
+			name:    "empty and non-empty",
+			b:       []int{1, 2},
+			union:   []int{1, 2},
+			symDiff: []int{1, 2},
+			subset:  true,
+		},
+		{
+			name:    "overlapping",
+			a:       []int{1, 2, 3, 4},
+			b:       []int{3, 4, 5},
+			union:   []int{1, 2, 3, 4, 5},
+			inter:   []int{3, 4},
+			diff:    []int{1, 2},
+			symDiff: []int{1, 2, 5},
+		},
+		{
+			name:    "proper subset",
+			a:       []int{2, 3},
+			b:       []int{1, 2, 3},
+			union:   []int{1, 2, 3},
+			inter:   []int{2, 3},
+			symDiff: []int{1},
+			subset:  true,
+		},
+		{
+			name:   "equal",
+			a:      []int{1, 2},
+			b:      []int{2, 1},
+			union:  []int{1, 2},
+			inter:  []int{1, 2},
+			subset: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NewSet(tt.a...), NewSet(tt.b...)
+			results := []struct {
+				op   string
+				got  *Set[int]
+				want []int
+			}{
+				{"Union", a.Union(b), tt.union},
+				{"Intersect", a.Intersect(b), tt.inter},
+				{"Difference", a.Difference(b), tt.diff},
+				{"SymmetricDifference", a.SymmetricDifference(b), tt.symDiff},
+			}
+			for _, r := range results {
+				if got := slices.Sorted(r.got.Iter()); !slices.Equal(got, r.want) {
+					t.Errorf("%s() = %v, want %v", r.op, got, r.want)
+				}
+			}
+			if got := a.IsSubset(b); got != tt.subset {
+				t.Errorf("IsSubset() = %v, want %v", got, tt.subset)
+			}
+			if got, want := a.Equal(b), slices.Equal(slices.Sorted(a.Iter()), slices.Sorted(b.Iter())); got != want {
+				t.Errorf("Equal() = %v, want %v", got, want)
+			}
+			if got, want := slices.Sorted(a.Iter()), slices.Compact(slices.Sorted(slices.Values(tt.a))); !slices.Equal(got, want) {
+				t.Errorf("operand changed to %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSetList(t *testing.T) {
+	l := NewCSLList(3, 1, 3, 2, 1)
+	s := NewSetFromList[int](l)
+	if got := slices.Sorted(s.Iter()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("NewSetFromList() = %v, want [1 2 3]", got)
+	}
+
+	back := s.ToList()
+	if back.Length() != 3 {
+		t.Fatalf("ToList() = %v, want 3 elements", back)
+	}
+	for _, v := range []int{1, 2, 3} {
+		if back.FindFirst(v) == -1 {
+			t.Errorf("ToList() = %v, missing %d", back, v)
+		}
+	}
+
+	other := NewSetFromList[int](NewSkipList(2, 2, 5))
+	if got := slices.Sorted(s.Intersect(other).Iter()); !slices.Equal(got, []int{2}) {
+		t.Errorf("set of a SkipList intersected = %v, want [2]", got)
+	}
+}
+
+func BenchmarkSetUnion(b *testing.B) {
+	x, y := NewSet[int](), NewSet[int]()
+	for i := range 10000 {
+		x.Add(i)
+		y.Add(i + 5000)
+	}
+	for b.Loop() {
+		x.Union(y)
+	}
+}
@@ -0,0 +1,326 @@
+package ds
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/bits"
+	"math/rand/v2"
+)
+
+const skipMaxLevel = 32
+
+type skipNode[E comparable] struct {
+	data E
+	next []*skipNode[E]
+	// span[k] is the number of positions the link next[k] jumps over
+	span []int
+}
+
+// SkipList is an indexable skip list: every link stores how many elements
+// it skips, so positional operations take expected O(log n) steps.
+type SkipList[E comparable] struct {
+	head  *skipNode[E]
+	level int
+	len   int
+}
+
+var _ List[int] = (*SkipList[int])(nil)
+
+func NewSkipList[E comparable](vs ...E) *SkipList[E] {
+	l := &SkipList[E]{}
+	l.Clear()
+	for _, v := range vs {
+		l.Append(v)
+	}
+	return l
+}
+
+func newSkipNode[E comparable](v E, level int) *skipNode[E] {
+	return &skipNode[E]{
+		data: v,
+		next: make([]*skipNode[E], level),
+		span: make([]int, level),
+	}
+}
+
+func randomSkipLevel() int {
+	// every level is promoted with probability 1/4
+	lvl := 1 + bits.TrailingZeros64(rand.Uint64()|1<<62)/2
+	return min(lvl, skipMaxLevel)
+}
+
+func (l *SkipList[E]) String() string {
+	return formatList("SkipList", l.len, l.Iter())
+}
+
+func (l *SkipList[E]) Length() int {
+	return l.len
+}
+
+func (l *SkipList[E]) Clone() *SkipList[E] {
+	c := NewSkipList[E]()
+	for _, v := range l.Iter() {
+		c.Append(v)
+	}
+	return c
+}
+
+// preds fills update with the rightmost node before position i on every
+// level and rank with the position of that node, the head being at 0.
+func (l *SkipList[E]) preds(i int, update *[skipMaxLevel]*skipNode[E], rank *[skipMaxLevel]int) {
+	x, traversed := l.head, 0
+	for k := l.level - 1; k >= 0; k-- {
+		for x.next[k] != nil && traversed+x.span[k] <= i {
+			traversed += x.span[k]
+			x = x.next[k]
+		}
+		update[k], rank[k] = x, traversed
+	}
+}
+
+// predsFunc is like preds, but positions the search before the first element
+// that is not less than v according to compare.
+func (l *SkipList[E]) predsFunc(v E, compare func(a, b E) int, update *[skipMaxLevel]*skipNode[E], rank *[skipMaxLevel]int) {
+	x, traversed := l.head, 0
+	for k := l.level - 1; k >= 0; k-- {
+		for x.next[k] != nil && compare(x.next[k].data, v) < 0 {
+			traversed += x.span[k]
+			x = x.next[k]
+		}
+		update[k], rank[k] = x, traversed
+	}
+}
+
+func (l *SkipList[E]) insertAfter(v E, update *[skipMaxLevel]*skipNode[E], rank *[skipMaxLevel]int) {
+	lvl := randomSkipLevel()
+	if lvl > l.level {
+		for k := l.level; k < lvl; k++ {
+			update[k], rank[k] = l.head, 0
+			l.head.span[k] = l.len
+		}
+		l.level = lvl
+	}
+
+	n := newSkipNode(v, lvl)
+	for k := range lvl {
+		n.next[k] = update[k].next[k]
+		update[k].next[k] = n
+		n.span[k] = update[k].span[k] - (rank[0] - rank[k])
+		update[k].span[k] = rank[0] - rank[k] + 1
+	}
+	for k := lvl; k < l.level; k++ {
+		update[k].span[k]++
+	}
+	l.len++
+}
+
+func (l *SkipList[E]) deleteAfter(update *[skipMaxLevel]*skipNode[E]) E {
+	x := update[0].next[0]
+	for k := range l.level {
+		if update[k].next[k] == x {
+			update[k].span[k] += x.span[k] - 1
+			update[k].next[k] = x.next[k]
+		} else {
+			update[k].span[k]--
+		}
+	}
+	for l.level > 1 && l.head.next[l.level-1] == nil {
+		l.level--
+	}
+	l.len--
+	return x.data
+}
+
+func (l *SkipList[E]) Get(i int) (E, error) {
+	var zero E
+	if i < 0 {
+		return zero, fmt.Errorf("%w [%d]", ErrIndexOutOfRange, i)
+	}
+	if i >= l.len {
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	var update [skipMaxLevel]*skipNode[E]
+	var rank [skipMaxLevel]int
+	l.preds(i, &update, &rank)
+	return update[0].next[0].data, nil
+}
+
+func (l *SkipList[E]) FindFirst(v E) int {
+	for i, val := range l.Iter() {
+		if val == v {
+			return i
+		}
+	}
+	return -1
+}
+
+func (l *SkipList[E]) FindLast(v E) int {
+	last := -1
+	for i, val := range l.Iter() {
+		if val == v {
+			last = i
+		}
+	}
+	return last
+}
+
+func (l *SkipList[E]) Append(v E) {
+	l.Insert(v, l.len)
+}
+
+func (l *SkipList[E]) Insert(v E, i int) error {
+	if i < 0 {
+		return fmt.Errorf("%w [%d:]", ErrListBounds, i)
+	}
+	if i > l.len {
+		return fmt.Errorf("%w [%d:%d]", ErrListBounds, i, l.len)
+	}
+	var update [skipMaxLevel]*skipNode[E]
+	var rank [skipMaxLevel]int
+	l.preds(i, &update, &rank)
+	l.insertAfter(v, &update, &rank)
+	return nil
+}
+
+func (l *SkipList[E]) Extend(es *SkipList[E]) {
+	if es == nil {
+		return
+	}
+	for _, v := range es.Iter() {
+		l.Append(v)
+	}
+}
+
+func (l *SkipList[E]) Reverse() {
+	vs := make([]E, 0, l.len)
+	for _, v := range l.Iter() {
+		vs = append(vs, v)
+	}
+	l.Clear()
+	for i := len(vs) - 1; i >= 0; i-- {
+		l.Append(vs[i])
+	}
+}
+
+func (l *SkipList[E]) Delete(i int) (E, error) {
+	var zero E
+	if i < 0 {
+		return zero, fmt.Errorf("%w [%d]", ErrIndexOutOfRange, i)
+	}
+	if i >= l.len {
+		return zero, fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.len)
+	}
+	var update [skipMaxLevel]*skipNode[E]
+	var rank [skipMaxLevel]int
+	l.preds(i, &update, &rank)
+	return l.deleteAfter(&update), nil
+}
+
+func (l *SkipList[E]) DeleteAll(v E) {
+	vs := make([]E, 0, l.len)
+	for _, val := range l.Iter() {
+		if val != v {
+			vs = append(vs, val)
+		}
+	}
+	if len(vs) == l.len {
+		return
+	}
+	l.Clear()
+	for _, val := range vs {
+		l.Append(val)
+	}
+}
+
+func (l *SkipList[E]) Clear() {
+	l.head = newSkipNode[E](*new(E), skipMaxLevel)
+	l.level = 1
+	l.len = 0
+}
+
+func (l *SkipList[E]) Iter() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		curr := l.head
+		for i := range l.len {
+			curr = curr.next[0]
+			if curr == nil || !yield(i, curr.data) {
+				return
+			}
+		}
+	}
+}
+
+// SkipSet is an ordered set kept in a SkipList, so elements can also be
+// accessed by their rank.
+type SkipSet[E cmp.Ordered] struct {
+	l *SkipList[E]
+}
+
+func NewSkipSet[E cmp.Ordered](vs ...E) *SkipSet[E] {
+	s := &SkipSet[E]{NewSkipList[E]()}
+	for _, v := range vs {
+		s.Add(v)
+	}
+	return s
+}
+
+func (s *SkipSet[E]) String() string {
+	return formatList("SkipSet", s.l.len, s.l.Iter())
+}
+
+func (s *SkipSet[E]) Length() int {
+	return s.l.len
+}
+
+func (s *SkipSet[E]) Add(v E) bool {
+	var update [skipMaxLevel]*skipNode[E]
+	var rank [skipMaxLevel]int
+	s.l.predsFunc(v, cmp.Compare[E], &update, &rank)
+	if next := update[0].next[0]; next != nil && cmp.Compare(next.data, v) == 0 {
+		return false
+	}
+	s.l.insertAfter(v, &update, &rank)
+	return true
+}
+
+func (s *SkipSet[E]) Remove(v E) bool {
+	var update [skipMaxLevel]*skipNode[E]
+	var rank [skipMaxLevel]int
+	s.l.predsFunc(v, cmp.Compare[E], &update, &rank)
+	if next := update[0].next[0]; next == nil || cmp.Compare(next.data, v) != 0 {
+		return false
+	}
+	s.l.deleteAfter(&update)
+	return true
+}
+
+func (s *SkipSet[E]) Contains(v E) bool {
+	_, ok := s.Rank(v)
+	return ok
+}
+
+// Rank returns the number of elements less than v and whether v is in the set.
+func (s *SkipSet[E]) Rank(v E) (int, bool) {
+	var update [skipMaxLevel]*skipNode[E]
+	var rank [skipMaxLevel]int
+	s.l.predsFunc(v, cmp.Compare[E], &update, &rank)
+	next := update[0].next[0]
+	return rank[0], next != nil && cmp.Compare(next.data, v) == 0
+}
+
+func (s *SkipSet[E]) Get(i int) (E, error) {
+	return s.l.Get(i)
+}
+
+func (s *SkipSet[E]) Delete(i int) (E, error) {
+	return s.l.Delete(i)
+}
+
+func (s *SkipSet[E]) Clear() {
+	s.l.Clear()
+}
+
+func (s *SkipSet[E]) Iter() iter.Seq2[int, E] {
+	return s.l.Iter()
+}
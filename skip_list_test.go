@@ -0,0 +1,270 @@
+package ds
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestSkipListOperations(t *testing.T) {
+	tests := []struct {
+		name    string
+		init    []int
+		op      func(l *SkipList[int]) error
+		want    string
+		wantErr error
+	}{
+		{
+			name: "empty",
+			init: []int{},
+			op:   func(l *SkipList[int]) error { return nil },
+			want: "SkipList{  }",
+		},
+		{
+			name: "append",
+			init: []int{1, 2},
+			op:   func(l *SkipList[int]) error { l.Append(3); return nil },
+			want: "SkipList{ 1 2 3 }",
+		},
+		{
+			name: "insert at beginning",
+			init: []int{1, 2},
+			op:   func(l *SkipList[int]) error { return l.Insert(0, 0) },
+			want: "SkipList{ 0 1 2 }",
+		},
+		{
+			name:    "insert out of bounds",
+			init:    []int{1, 2},
+			op:      func(l *SkipList[int]) error { return l.Insert(0, -1) },
+			want:    "SkipList{ 1 2 }",
+			wantErr: ErrListBounds,
+		},
+		{
+			name: "delete middle",
+			init: []int{1, 2, 3},
+			op: func(l *SkipList[int]) error {
+				_, err := l.Delete(1)
+				return err
+			},
+			want: "SkipList{ 1 3 }",
+		},
+		{
+			name: "delete out of range",
+			init: []int{1, 2, 3},
+			op: func(l *SkipList[int]) error {
+				_, err := l.Delete(3)
+				return err
+			},
+			want:    "SkipList{ 1 2 3 }",
+			wantErr: ErrIndexOutOfRange,
+		},
+		{
+			name: "get out of range",
+			init: []int{},
+			op: func(l *SkipList[int]) error {
+				_, err := l.Get(0)
+				return err
+			},
+			want:    "SkipList{  }",
+			wantErr: ErrIndexOutOfRange,
+		},
+		{
+			name: "delete all",
+			init: []int{2, 1, 2, 3, 2},
+			op:   func(l *SkipList[int]) error { l.DeleteAll(2); return nil },
+			want: "SkipList{ 1 3 }",
+		},
+		{
+			name: "reverse",
+			init: []int{1, 2, 3},
+			op:   func(l *SkipList[int]) error { l.Reverse(); return nil },
+			want: "SkipList{ 3 2 1 }",
+		},
+		{
+			name: "extend with itself",
+			init: []int{1, 2},
+			op:   func(l *SkipList[int]) error { l.Extend(l); return nil },
+			want: "SkipList{ 1 2 1 2 }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewSkipList(tt.init...)
+			err := tt.op(l)
+
+			if got := l.String(); got != tt.want {
+				t.Errorf("list after operation = %v, want %v", got, tt.want)
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("operation error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected operation error = %v", err)
+			}
+		})
+	}
+}
+
+func checkSkipListSpans[E comparable](t *testing.T, l *SkipList[E]) {
+	t.Helper()
+	pos := map[*skipNode[E]]int{l.head: 0}
+	curr := l.head
+	for i := range l.len {
+		curr = curr.next[0]
+		pos[curr] = i + 1
+	}
+	for x := range pos {
+		for k := range min(len(x.next), l.level) {
+			if x.next[k] != nil && pos[x.next[k]]-pos[x] != x.span[k] {
+				t.Fatalf("span at level %d from position %d = %d, want %d", k, pos[x], x.span[k], pos[x.next[k]]-pos[x])
+			}
+		}
+	}
+}
+
+func TestSkipListModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	l := NewSkipList[int]()
+	var model []int
+
+	for step := range 5000 {
+		switch op := r.IntN(10); {
+		case op < 5:
+			v, i := r.IntN(100), r.IntN(len(model)+1)
+			if err := l.Insert(v, i); err != nil {
+				t.Fatalf("step %d: Insert(%d, %d) error = %v", step, v, i, err)
+			}
+			model = slices.Insert(model, i, v)
+		case op < 9:
+			if len(model) == 0 {
+				continue
+			}
+			i := r.IntN(len(model))
+			got, err := l.Delete(i)
+			if err != nil || got != model[i] {
+				t.Fatalf("step %d: Delete(%d) = (%v, %v), want (%v, nil)", step, i, got, err, model[i])
+			}
+			model = slices.Delete(model, i, i+1)
+		default:
+			v := r.IntN(100)
+			l.DeleteAll(v)
+			model = slices.DeleteFunc(model, func(e int) bool { return e == v })
+		}
+
+		if len(model) > 0 {
+			i := r.IntN(len(model))
+			if got, _ := l.Get(i); got != model[i] {
+				t.Fatalf("step %d: Get(%d) = %v, want %v", step, i, got, model[i])
+			}
+		}
+		if step%250 == 0 {
+			checkSkipListSpans(t, l)
+		}
+	}
+
+	var got []int
+	for _, v := range l.Iter() {
+		got = append(got, v)
+	}
+	if !slices.Equal(got, model) {
+		t.Errorf("Iter() produced %v, want %v", got, model)
+	}
+}
+
+func TestSkipSet(t *testing.T) {
+	tests := []struct {
+		name      string
+		init      []int
+		add       []int
+		remove    []int
+		want      string
+		wantRanks map[int]int
+	}{
+		{
+			name: "empty",
+			want: "SkipSet{  }",
+		},
+		{
+			name:      "sorted and deduplicated",
+			init:      []int{5, 1, 3, 1, 5},
+			want:      "SkipSet{ 1 3 5 }",
+			wantRanks: map[int]int{1: 0, 3: 1, 5: 2},
+		},
+		{
+			name:      "add and remove",
+			init:      []int{10, 20, 30},
+			add:       []int{25, 5, 20},
+			remove:    []int{10, 40},
+			want:      "SkipSet{ 5 20 25 30 }",
+			wantRanks: map[int]int{5: 0, 20: 1, 25: 2, 30: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSkipSet(tt.init...)
+			for _, v := range tt.add {
+				s.Add(v)
+			}
+			for _, v := range tt.remove {
+				s.Remove(v)
+			}
+
+			if got := s.String(); got != tt.want {
+				t.Errorf("set = %v, want %v", got, tt.want)
+			}
+
+			for v, want := range tt.wantRanks {
+				got, ok := s.Rank(v)
+				if got != want || !ok {
+					t.Errorf("Rank(%d) = (%d, %v), want (%d, true)", v, got, ok, want)
+				}
+				if got, err := s.Get(want); err != nil || got != v {
+					t.Errorf("Get(%d) = (%v, %v), want (%v, nil)", want, got, err, v)
+				}
+			}
+		})
+	}
+
+	t.Run("missing element", func(t *testing.T) {
+		s := NewSkipSet(10, 20, 30)
+		if got, ok := s.Rank(25); got != 2 || ok {
+			t.Errorf("Rank(25) = (%d, %v), want (2, false)", got, ok)
+		}
+		if s.Contains(25) {
+			t.Error("Contains(25) = true, want false")
+		}
+		if s.Remove(25) {
+			t.Error("Remove(25) = true, want false")
+		}
+		if !s.Add(25) || s.Add(25) {
+			t.Error("Add(25) twice, want true then false")
+		}
+	})
+}
+
+func BenchmarkSkipListGet(b *testing.B) {
+	l := NewSkipList[int]()
+	for i := range 100000 {
+		l.Append(i)
+	}
+	for i := 0; b.Loop(); i++ {
+		l.Get(i * 7919 % 100000)
+	}
+}
+
+func BenchmarkSkipListInsertDelete(b *testing.B) {
+	l := NewSkipList[int]()
+	for i := range 100000 {
+		l.Append(i)
+	}
+	for i := 0; b.Loop(); i++ {
+		idx := i * 7919 % 100000
+		l.Insert(i, idx)
+		l.Delete(idx)
+	}
+}
@@ -0,0 +1,46 @@
+package ds
+
+import "iter"
+
+// Stack keeps its top at the head of a cslList, so Push and Pop are O(1).
+type Stack[E comparable] struct {
+	l *cslList[E]
+}
+
+// NewStack pushes vs in order, so the last of them ends up on top.
+func NewStack[E comparable](vs ...E) *Stack[E] {
+	s := &Stack[E]{NewCSLList[E]()}
+	for _, v := range vs {
+		s.Push(v)
+	}
+	return s
+}
+
+func (s *Stack[E]) String() string {
+	return formatList("Stack", s.l.len, s.l.Iter())
+}
+
+func (s *Stack[E]) Len() int {
+	return s.l.len
+}
+
+func (s *Stack[E]) Push(v E) {
+	s.l.Insert(v, 0)
+}
+
+func (s *Stack[E]) Pop() (E, bool) {
+	if s.l.len == 0 {
+		var zero E
+		return zero, false
+	}
+	return s.l.MustDelete(0), true
+}
+
+func (s *Stack[E]) Peek() (E, bool) {
+	return s.l.At(0)
+}
+
+// Iter yields the elements from the top to the bottom of the stack.
+func (s *Stack[E]) Iter() iter.Seq2[int, E] {
+	return s.l.Iter()
+}
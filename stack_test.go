@@ -0,0 +1,103 @@
+package ds
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	tests := []struct {
+		name     string
+		init     []int
+		push     []int
+		pops     int
+		wantVals []int
+		wantOks  []bool
+		wantPeek int
+		wantLen  int
+		want     string
+	}{
+		{
+			name:     "empty",
+			init:     []int{},
+			pops:     1,
+			wantVals: []int{0},
+			wantOks:  []bool{false},
+			want:     "Stack{  }",
+		},
+		{
+			name:     "push only",
+			init:     []int{1, 2},
+			push:     []int{3, 4},
+			wantPeek: 4,
+			wantLen:  4,
+			want:     "Stack{ 4 3 2 1 }",
+		},
+		{
+			name:     "push and pop",
+			init:     []int{1, 2},
+			push:     []int{3, 4},
+			pops:     2,
+			wantVals: []int{4, 3},
+			wantOks:  []bool{true, true},
+			wantPeek: 2,
+			wantLen:  2,
+			want:     "Stack{ 2 1 }",
+		},
+		{
+			name:     "pop past empty",
+			init:     []int{1},
+			pops:     2,
+			wantVals: []int{1, 0},
+			wantOks:  []bool{true, false},
+			want:     "Stack{  }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStack(tt.init...)
+			for _, v := range tt.push {
+				s.Push(v)
+			}
+
+			var gotVals []int
+			var gotOks []bool
+			for range tt.pops {
+				v, ok := s.Pop()
+				gotVals = append(gotVals, v)
+				gotOks = append(gotOks, ok)
+			}
+
+			if !slices.Equal(gotVals, tt.wantVals) || !slices.Equal(gotOks, tt.wantOks) {
+				t.Errorf("Pop() returned %v %v, want %v %v", gotVals, gotOks, tt.wantVals, tt.wantOks)
+			}
+
+			gotPeek, ok := s.Peek()
+			if gotPeek != tt.wantPeek || ok != (tt.wantLen > 0) {
+				t.Errorf("Peek() = (%v, %v), want (%v, %v)", gotPeek, ok, tt.wantPeek, tt.wantLen > 0)
+			}
+
+			if got := s.Len(); got != tt.wantLen {
+				t.Errorf("Len() = %v, want %v", got, tt.wantLen)
+			}
+
+			if got := s.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("iter order", func(t *testing.T) {
+		s := NewStack(1, 2, 3)
+
+		var got []int
+		for _, v := range s.Iter() {
+			got = append(got, v)
+		}
+
+		if want := []int{3, 2, 1}; !slices.Equal(got, want) {
+			t.Errorf("Iter() produced %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package ds
+
+import (
+	"iter"
+	"sync"
+)
+
+type SyncList[E comparable] struct {
+	mu sync.RWMutex
+	l  List[E]
+}
+
+var _ List[int] = (*SyncList[int])(nil)
+
+func NewSyncList[E comparable](l List[E]) *SyncList[E] {
+	if l == nil {
+		l = NewCSLList[E]()
+	}
+	return &SyncList[E]{l: l}
+}
+
+func (s *SyncList[E]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.String()
+}
+
+func (s *SyncList[E]) Length() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Length()
+}
+
+func (s *SyncList[E]) Get(i int) (E, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Get(i)
+}
+
+func (s *SyncList[E]) FindFirst(v E) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.FindFirst(v)
+}
+
+func (s *SyncList[E]) FindLast(v E) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.FindLast(v)
+}
+
+func (s *SyncList[E]) Append(v E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Append(v)
+}
+
+func (s *SyncList[E]) Insert(v E, i int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.Insert(v, i)
+}
+
+func (s *SyncList[E]) Reverse() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Reverse()
+}
+
+func (s *SyncList[E]) Delete(i int) (E, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.Delete(i)
+}
+
+func (s *SyncList[E]) DeleteAll(v E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.DeleteAll(v)
+}
+
+func (s *SyncList[E]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Clear()
+}
+
+func (s *SyncList[E]) PopFrontIfLen(min int) (E, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.l.Length() == 0 || s.l.Length() < min {
+		var zero E
+		return zero, false
+	}
+	v, err := s.l.Delete(0)
+	return v, err == nil
+}
+
+func (s *SyncList[E]) Update(i int, fn func(E) E) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, err := s.l.Get(i)
+	if err != nil {
+		return err
+	}
+	// fn runs before the list changes, so a panic in it loses nothing
+	nv := fn(v)
+	if _, err := s.l.Delete(i); err != nil {
+		return err
+	}
+	return s.l.Insert(nv, i)
+}
+
+func (s *SyncList[E]) Snapshot() []E {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	vs := make([]E, 0, s.l.Length())
+	for _, v := range s.l.Iter() {
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func (s *SyncList[E]) Iter() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		for i, v := range s.Snapshot() {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package ds
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSyncListPopFrontIfLen(t *testing.T) {
+	tests := []struct {
+		name    string
+		init    []int
+		min     int
+		wantVal int
+		wantOk  bool
+		want    string
+	}{
+		{
+			name:    "enough elements",
+			init:    []int{1, 2, 3},
+			min:     3,
+			wantVal: 1,
+			wantOk:  true,
+			want:    "cslList{ 2 3 }",
+		},
+		{
+			name:   "not enough elements",
+			init:   []int{1, 2, 3},
+			min:    4,
+			wantOk: false,
+			want:   "cslList{ 1 2 3 }",
+		},
+		{
+			name:   "empty list",
+			init:   []int{},
+			min:    0,
+			wantOk: false,
+			want:   "cslList{  }",
+		},
+		{
+			name:    "zero min",
+			init:    []int{7},
+			min:     0,
+			wantVal: 7,
+			wantOk:  true,
+			want:    "cslList{  }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewSyncList[int](NewCSLList(tt.init...))
+			gotVal, gotOk := l.PopFrontIfLen(tt.min)
+
+			if gotVal != tt.wantVal || gotOk != tt.wantOk {
+				t.Errorf("PopFrontIfLen(%d) = (%v, %v), want (%v, %v)", tt.min, gotVal, gotOk, tt.wantVal, tt.wantOk)
+			}
+
+			if got := l.String(); got != tt.want {
+				t.Errorf("list after PopFrontIfLen() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncListUpdate(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+
+	tests := []struct {
+		name    string
+		init    []int
+		index   int
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "update first",
+			init:  []int{1, 2, 3},
+			index: 0,
+			want:  "cslList{ 2 2 3 }",
+		},
+		{
+			name:  "update last",
+			init:  []int{1, 2, 3},
+			index: 2,
+			want:  "cslList{ 1 2 6 }",
+		},
+		{
+			name:    "negative index",
+			init:    []int{1, 2, 3},
+			index:   -1,
+			want:    "cslList{ 1 2 3 }",
+			wantErr: ErrIndexOutOfRange,
+		},
+		{
+			name:    "empty list",
+			init:    []int{},
+			index:   0,
+			want:    "cslList{  }",
+			wantErr: ErrIndexOutOfRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewSyncList[int](NewCSLList(tt.init...))
+			err := l.Update(tt.index, double)
+
+			if got := l.String(); got != tt.want {
+				t.Errorf("list after Update() = %v, want %v", got, tt.want)
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Update() error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected Update() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestSyncListUpdatePanic(t *testing.T) {
+	l := NewSyncList[int](NewCSLList(1, 2, 3))
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Update() did not pass on the panic of fn")
+			}
+		}()
+		l.Update(1, func(int) int { panic("fn failed") })
+	}()
+
+	if got := l.String(); got != "cslList{ 1 2 3 }" {
+		t.Errorf("list after a panicking Update() = %v, want cslList{ 1 2 3 }", got)
+	}
+	l.Append(4)
+	if got := l.String(); got != "cslList{ 1 2 3 4 }" {
+		t.Errorf("list after Append(4) = %v, want the lock released", got)
+	}
+}
+
+func TestSyncListIter(t *testing.T) {
+	t.Run("nil list defaults to cslList", func(t *testing.T) {
+		l := NewSyncList[int](nil)
+		l.Append(1)
+		if got := l.String(); got != "cslList{ 1 }" {
+			t.Errorf("NewSyncList(nil).Append(1) = %v, want cslList{ 1 }", got)
+		}
+	})
+
+	t.Run("modification during iteration", func(t *testing.T) {
+		l := NewSyncList[int](NewCSLList(1, 2, 3))
+
+		var got []int
+		for _, v := range l.Iter() {
+			got = append(got, v)
+			l.Append(v * 10)
+		}
+
+		if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+			t.Errorf("Iter() produced %v, want %v", got, want)
+		}
+		if want := []int{1, 2, 3, 10, 20, 30}; !slices.Equal(l.Snapshot(), want) {
+			t.Errorf("Snapshot() = %v, want %v", l.Snapshot(), want)
+		}
+	})
+}
+
+func TestSyncListConcurrent(t *testing.T) {
+	const (
+		workers = 8
+		perWork = 200
+	)
+
+	l := NewSyncList[int](NewCSLList[int]())
+	var wg sync.WaitGroup
+
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWork {
+				l.Append(w*perWork + i)
+				l.Update(0, func(v int) int { return v })
+				for range l.Iter() {
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := l.Length(); got != workers*perWork {
+		t.Fatalf("Length() after concurrent appends = %d, want %d", got, workers*perWork)
+	}
+
+	popped := make(chan int, workers*perWork)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				v, ok := l.PopFrontIfLen(1)
+				if !ok {
+					return
+				}
+				popped <- v
+			}
+		}()
+	}
+	wg.Wait()
+	close(popped)
+
+	seen := make(map[int]bool, workers*perWork)
+	for v := range popped {
+		if seen[v] {
+			t.Fatalf("value %d popped twice", v)
+		}
+		seen[v] = true
+	}
+	if len(seen) != workers*perWork {
+		t.Errorf("popped %d distinct values, want %d", len(seen), workers*perWork)
+	}
+	if got := l.Length(); got != 0 {
+		t.Errorf("Length() after draining = %d, want 0", got)
+	}
+}
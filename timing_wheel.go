@@ -0,0 +1,183 @@
+package ds
+
+import (
+	"time"
+)
+
+// Timer is a handle to a callback scheduled on a TimingWheel.
+type Timer struct {
+	fn      func()
+	expires int64
+	bucket  *CDLList[*Timer]
+	node    *CDLNode[*Timer]
+}
+
+// Pending reports whether the timer has neither fired nor been cancelled.
+func (t *Timer) Pending() bool {
+	return t.bucket != nil
+}
+
+type TimingWheelOption func(*TimingWheel)
+
+// WithWheelClock replaces time.Now as the source of the current time.
+func WithWheelClock(now func() time.Time) TimingWheelOption {
+	return func(w *TimingWheel) {
+		w.now = now
+	}
+}
+
+// TimingWheel keeps timers in a ring of size buckets, each covering one tick.
+// Timers too far ahead for the ring go to overflow wheels whose buckets cover
+// a whole turn of the wheel below and are added as needed. The wheel does not
+// run on its own: Advance fires the expired timers, so the callbacks run on
+// the goroutine calling it. It is not safe for concurrent use.
+type TimingWheel struct {
+	tick   time.Duration
+	size   int
+	now    func() time.Time
+	origin time.Time
+	// current is the number of ticks since origin handled by Advance
+	current int64
+	// target is the tick the running Advance handles up to
+	target int64
+	levels [][]*CDLList[*Timer]
+	// due holds timers scheduled for a tick up to target, which wait for the
+	// next Advance
+	due *CDLList[*Timer]
+	len int
+}
+
+func NewTimingWheel(tick time.Duration, size int, opts ...TimingWheelOption) *TimingWheel {
+	w := &TimingWheel{
+		tick: max(tick, 1),
+		size: max(size, 2),
+		now:  time.Now,
+		due:  NewCDLList[*Timer](),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	w.origin = w.now()
+	return w
+}
+
+// Len returns the number of pending timers.
+func (w *TimingWheel) Len() int {
+	return w.len
+}
+
+func (w *TimingWheel) newLevel() []*CDLList[*Timer] {
+	buckets := make([]*CDLList[*Timer], w.size)
+	for i := range buckets {
+		buckets[i] = NewCDLList[*Timer]()
+	}
+	return buckets
+}
+
+// Schedule arranges for fn to run on the first Advance at least delay after
+// now.
+func (w *TimingWheel) Schedule(delay time.Duration, fn func()) *Timer {
+	elapsed := w.now().Add(delay).Sub(w.origin)
+	expires := int64(elapsed / w.tick)
+	if elapsed%w.tick > 0 {
+		expires++
+	}
+	t := &Timer{fn: fn, expires: expires}
+	if expires <= max(w.current, w.target) {
+		t.bucket = w.due
+		t.node = w.due.PushBack(t)
+	} else {
+		w.add(t)
+	}
+	w.len++
+	return t
+}
+
+// add puts t into the bucket of its tick, which must not have been handled by
+// Advance yet.
+func (w *TimingWheel) add(t *Timer) {
+	diff := t.expires - w.current
+	span := int64(1)
+	level := 0
+	for diff >= span*int64(w.size) {
+		span *= int64(w.size)
+		level++
+	}
+	for len(w.levels) <= level {
+		w.levels = append(w.levels, w.newLevel())
+	}
+	t.bucket = w.levels[level][(t.expires/span)%int64(w.size)]
+	t.node = t.bucket.PushBack(t)
+}
+
+// Cancel stops t and reports whether it was still pending.
+func (w *TimingWheel) Cancel(t *Timer) bool {
+	if t.bucket == nil {
+		return false
+	}
+	t.bucket.Remove(t.node)
+	t.bucket, t.node = nil, nil
+	w.len--
+	return true
+}
+
+// Advance runs the timers that expired up to now and returns how many ran.
+// Timers that the callbacks schedule run on a later Advance, even if they
+// are already due.
+func (w *TimingWheel) Advance() int {
+	target := int64(w.now().Sub(w.origin) / w.tick)
+	w.target = target
+	due := w.due
+	w.due = NewCDLList[*Timer]()
+	fired := w.fire(due)
+	for w.current < target {
+		if w.len == 0 {
+			w.current = target
+			break
+		}
+		w.current++
+		// move the timers of the overflow buckets starting at this tick
+		// down before firing the ones of the lowest wheel
+		span := int64(1)
+		for level := range w.levels {
+			if w.current%span != 0 {
+				break
+			}
+			if level > 0 {
+				w.cascade(w.levels[level], (w.current/span)%int64(w.size))
+			}
+			span *= int64(w.size)
+		}
+		if len(w.levels) > 0 {
+			i := w.current % int64(w.size)
+			b := w.levels[0][i]
+			w.levels[0][i] = NewCDLList[*Timer]()
+			fired += w.fire(b)
+		}
+	}
+	return fired
+}
+
+func (w *TimingWheel) cascade(buckets []*CDLList[*Timer], i int64) {
+	b := buckets[i]
+	buckets[i] = NewCDLList[*Timer]()
+	for b.Length() > 0 {
+		t := b.Remove(b.Front())
+		w.add(t)
+	}
+}
+
+// fire runs the timers of b, which must be detached from the wheel so that
+// the timers the callbacks schedule do not join it. Callbacks may cancel the
+// timers of b that have not run yet.
+func (w *TimingWheel) fire(b *CDLList[*Timer]) int {
+	fired := 0
+	for b.Length() > 0 {
+		t := b.Remove(b.Front())
+		t.bucket, t.node = nil, nil
+		w.len--
+		t.fn()
+		fired++
+	}
+	return fired
+}
@@ -0,0 +1,222 @@
+package ds
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+)
+
+func newTestTimingWheel(size int) (*TimingWheel, *fakeClock) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	return NewTimingWheel(time.Millisecond, size, WithWheelClock(clock.Now)), clock
+}
+
+func TestTimingWheelSchedule(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   int
+		delays []time.Duration
+		steps  []time.Duration
+		// want lists the indexes of delays fired after each step
+		want [][]int
+	}{
+		{
+			name:   "fires after the deadline",
+			size:   8,
+			delays: []time.Duration{3 * time.Millisecond, time.Millisecond},
+			steps:  []time.Duration{0, time.Millisecond, time.Millisecond, time.Millisecond},
+			want:   [][]int{nil, {1}, nil, {0}},
+		},
+		{
+			name:   "rounds partial ticks up",
+			size:   8,
+			delays: []time.Duration{1500 * time.Microsecond},
+			steps:  []time.Duration{time.Millisecond, time.Millisecond},
+			want:   [][]int{nil, {0}},
+		},
+		{
+			name:   "zero and negative delays fire on the next advance",
+			size:   8,
+			delays: []time.Duration{0, -time.Second},
+			steps:  []time.Duration{0},
+			want:   [][]int{{0, 1}},
+		},
+		{
+			name:   "overflow wheels",
+			size:   4,
+			delays: []time.Duration{5 * time.Millisecond, 17 * time.Millisecond, 70 * time.Millisecond},
+			steps:  []time.Duration{4 * time.Millisecond, time.Millisecond, 11 * time.Millisecond, time.Millisecond, 53 * time.Millisecond, time.Millisecond},
+			want:   [][]int{nil, {0}, nil, {1}, {2}, nil},
+		},
+		{
+			name:   "one big step",
+			size:   4,
+			delays: []time.Duration{70 * time.Millisecond, 2 * time.Millisecond, 17 * time.Millisecond},
+			steps:  []time.Duration{time.Second},
+			want:   [][]int{{1, 2, 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, clock := newTestTimingWheel(tt.size)
+			var fired []int
+			for i, d := range tt.delays {
+				w.Schedule(d, func() { fired = append(fired, i) })
+			}
+			for s, d := range tt.steps {
+				fired = nil
+				clock.Advance(d)
+				if n := w.Advance(); n != len(fired) {
+					t.Errorf("step %d: Advance() = %d, want %d", s, n, len(fired))
+				}
+				if !slices.Equal(fired, tt.want[s]) {
+					t.Errorf("step %d: fired %v, want %v", s, fired, tt.want[s])
+				}
+			}
+			if w.Len() != 0 {
+				t.Errorf("Len() = %d after every timer fired, want 0", w.Len())
+			}
+		})
+	}
+}
+
+func TestTimingWheelCancel(t *testing.T) {
+	w, clock := newTestTimingWheel(4)
+	var fired []string
+	a := w.Schedule(2*time.Millisecond, func() { fired = append(fired, "a") })
+	b := w.Schedule(30*time.Millisecond, func() { fired = append(fired, "b") })
+	var c *Timer
+	w.Schedule(2*time.Millisecond, func() {
+		fired = append(fired, "cancel c")
+		w.Cancel(c)
+	})
+	c = w.Schedule(2*time.Millisecond, func() { fired = append(fired, "c") })
+
+	if w.Len() != 4 || !b.Pending() {
+		t.Fatalf("Len() = %d, want 4 pending timers", w.Len())
+	}
+	if !w.Cancel(b) || w.Cancel(b) || b.Pending() {
+		t.Error("Cancel(b) twice, want true then false")
+	}
+
+	clock.Advance(time.Minute)
+	w.Advance()
+	if want := []string{"a", "cancel c"}; !slices.Equal(fired, want) {
+		t.Errorf("fired %v, want %v", fired, want)
+	}
+	if w.Cancel(a) || a.Pending() {
+		t.Error("Cancel() of a fired timer succeeded")
+	}
+	if w.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", w.Len())
+	}
+}
+
+func TestTimingWheelReschedule(t *testing.T) {
+	w, clock := newTestTimingWheel(8)
+	var ticks []time.Duration
+	var tick func()
+	tick = func() {
+		ticks = append(ticks, clock.Now().Sub(time.Unix(0, 0)))
+		if len(ticks) < 4 {
+			w.Schedule(10*time.Millisecond, tick)
+		}
+	}
+	w.Schedule(10*time.Millisecond, tick)
+
+	for range 50 {
+		clock.Advance(time.Millisecond)
+		w.Advance()
+	}
+	want := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond, 40 * time.Millisecond}
+	if !slices.Equal(ticks, want) {
+		t.Errorf("periodic timer fired at %v, want %v", ticks, want)
+	}
+}
+
+func TestTimingWheelModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(9, 10))
+	w, clock := newTestTimingWheel(4)
+	start := clock.Now()
+	deadlines := map[int]time.Time{}
+	timers := map[int]*Timer{}
+
+	for id := range 2000 {
+		if r.IntN(4) == 0 {
+			clock.Advance(time.Duration(r.IntN(20)) * time.Millisecond)
+			w.Advance()
+		}
+		if r.IntN(5) == 0 && len(timers) > 0 {
+			for k, tm := range timers {
+				w.Cancel(tm)
+				delete(timers, k)
+				delete(deadlines, k)
+				break
+			}
+		}
+		delay := time.Duration(r.IntN(300)) * time.Millisecond
+		deadlines[id] = clock.Now().Add(delay)
+		timers[id] = w.Schedule(delay, func() {
+			now := clock.Now()
+			if now.Before(deadlines[id]) {
+				t.Errorf("timer %d fired at %v, before its deadline %v", id, now.Sub(start), deadlines[id].Sub(start))
+			}
+			if now.Sub(deadlines[id]) >= 20*time.Millisecond {
+				t.Errorf("timer %d fired at %v, more than a step after its deadline %v", id, now.Sub(start), deadlines[id].Sub(start))
+			}
+			delete(timers, id)
+		})
+	}
+
+	for w.Len() > 0 {
+		clock.Advance(time.Millisecond)
+		w.Advance()
+	}
+	if len(timers) != 0 {
+		t.Errorf("%d timers never fired", len(timers))
+	}
+}
+
+func BenchmarkTimingWheelScheduleCancel(b *testing.B) {
+	w, _ := newTestTimingWheel(256)
+	fn := func() {}
+	for i := 0; b.Loop(); i++ {
+		w.Cancel(w.Schedule(time.Duration(i%100000)*time.Millisecond, fn))
+	}
+}
+
+func BenchmarkTimingWheelAdvance(b *testing.B) {
+	w, clock := newTestTimingWheel(256)
+	fn := func() {}
+	for i := 0; b.Loop(); i++ {
+		w.Schedule(time.Duration(i%1000)*time.Millisecond, fn)
+		clock.Advance(time.Millisecond)
+		w.Advance()
+	}
+}
+
+func TestTimingWheelRescheduleNow(t *testing.T) {
+	w, clock := newTestTimingWheel(8)
+	runs := 0
+	var tick func()
+	tick = func() {
+		runs++
+		w.Schedule(0, tick)
+	}
+	w.Schedule(0, tick)
+	w.Schedule(time.Millisecond, tick)
+
+	for s, want := range []int{1, 2, 2} {
+		if s > 0 {
+			clock.Advance(time.Millisecond)
+		}
+		if n := w.Advance(); n != want {
+			t.Errorf("step %d: Advance() = %d, want %d", s, n, want)
+		}
+	}
+	if runs != 5 || w.Len() != 2 {
+		t.Errorf("callbacks ran %d times with %d pending, want 5 and 2", runs, w.Len())
+	}
+}
@@ -0,0 +1,380 @@
+package ds
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+type treeNode[K, V any] struct {
+	key    K
+	value  V
+	left   *treeNode[K, V]
+	right  *treeNode[K, V]
+	height int
+	size   int
+}
+
+func (n *treeNode[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *treeNode[K, V]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// treeAugment recomputes the data a tree keeps in a node beyond its height
+// and size from the node and its children. It is nil for trees keeping no
+// such data.
+type treeAugment[K, V any] func(n *treeNode[K, V])
+
+func (n *treeNode[K, V]) update(aug treeAugment[K, V]) {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.size = 1 + n.left.getSize() + n.right.getSize()
+	if aug != nil {
+		aug(n)
+	}
+}
+
+func (n *treeNode[K, V]) balanceFactor() int {
+	return n.left.getHeight() - n.right.getHeight()
+}
+
+func (n *treeNode[K, V]) rotateRight(aug treeAugment[K, V]) *treeNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update(aug)
+	l.update(aug)
+	return l
+}
+
+func (n *treeNode[K, V]) rotateLeft(aug treeAugment[K, V]) *treeNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update(aug)
+	r.update(aug)
+	return r
+}
+
+func (n *treeNode[K, V]) rebalance(aug treeAugment[K, V]) *treeNode[K, V] {
+	n.update(aug)
+	switch bf := n.balanceFactor(); {
+	case bf > 1:
+		if n.left.balanceFactor() < 0 {
+			n.left = n.left.rotateLeft(aug)
+		}
+		return n.rotateRight(aug)
+	case bf < -1:
+		if n.right.balanceFactor() > 0 {
+			n.right = n.right.rotateRight(aug)
+		}
+		return n.rotateLeft(aug)
+	}
+	return n
+}
+
+// treePut maps k to v in the subtree of n and returns its new root.
+func treePut[K, V any](n *treeNode[K, V], k K, v V, cmp func(a, b K) int, aug treeAugment[K, V]) *treeNode[K, V] {
+	if n == nil {
+		n = &treeNode[K, V]{key: k, value: v}
+		n.update(aug)
+		return n
+	}
+	switch c := cmp(k, n.key); {
+	case c < 0:
+		n.left = treePut(n.left, k, v, cmp, aug)
+	case c > 0:
+		n.right = treePut(n.right, k, v, cmp, aug)
+	default:
+		n.value = v
+		n.update(aug)
+		return n
+	}
+	return n.rebalance(aug)
+}
+
+// treeDelete removes k from the subtree of n, sets deleted if it was there
+// and returns the new root.
+func treeDelete[K, V any](n *treeNode[K, V], k K, cmp func(a, b K) int, aug treeAugment[K, V], deleted *bool) *treeNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := cmp(k, n.key); {
+	case c < 0:
+		n.left = treeDelete(n.left, k, cmp, aug, deleted)
+	case c > 0:
+		n.right = treeDelete(n.right, k, cmp, aug, deleted)
+	default:
+		*deleted = true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		var succ *treeNode[K, V]
+		n.right = deleteMin(n.right, &succ, aug)
+		succ.left, succ.right = n.left, n.right
+		n = succ
+	}
+	return n.rebalance(aug)
+}
+
+// deleteMin unlinks the leftmost node of n and stores it in min.
+func deleteMin[K, V any](n *treeNode[K, V], min **treeNode[K, V], aug treeAugment[K, V]) *treeNode[K, V] {
+	if n.left == nil {
+		*min = n
+		return n.right
+	}
+	n.left = deleteMin(n.left, min, aug)
+	return n.rebalance(aug)
+}
+
+// TreeMap is an ordered map kept in an AVL tree whose nodes also store the
+// size of their subtrees, so ranks are found in O(log n).
+type TreeMap[K, V any] struct {
+	root *treeNode[K, V]
+	cmp  func(a, b K) int
+}
+
+func NewTreeMap[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](cmp.Compare[K])
+}
+
+func NewTreeMapFunc[K, V any](cmp func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{cmp: cmp}
+}
+
+func (m *TreeMap[K, V]) Len() int {
+	return m.root.getSize()
+}
+
+func (m *TreeMap[K, V]) Get(k K) (V, bool) {
+	n := m.root
+	for n != nil {
+		switch c := m.cmp(k, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+func (m *TreeMap[K, V]) Put(k K, v V) {
+	m.root = treePut(m.root, k, v, m.cmp, nil)
+}
+
+func (m *TreeMap[K, V]) Delete(k K) bool {
+	var deleted bool
+	m.root = treeDelete(m.root, k, m.cmp, nil, &deleted)
+	return deleted
+}
+
+func (m *TreeMap[K, V]) Min() (K, V, bool) {
+	if m.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	n := m.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, n.value, true
+}
+
+func (m *TreeMap[K, V]) Max() (K, V, bool) {
+	if m.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	n := m.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Floor returns the greatest key less than or equal to k.
+func (m *TreeMap[K, V]) Floor(k K) (K, V, bool) {
+	var found *treeNode[K, V]
+	n := m.root
+	for n != nil {
+		c := m.cmp(k, n.key)
+		if c == 0 {
+			return n.key, n.value, true
+		}
+		if c < 0 {
+			n = n.left
+		} else {
+			found, n = n, n.right
+		}
+	}
+	return nodeEntry(found)
+}
+
+// Ceiling returns the least key greater than or equal to k.
+func (m *TreeMap[K, V]) Ceiling(k K) (K, V, bool) {
+	var found *treeNode[K, V]
+	n := m.root
+	for n != nil {
+		c := m.cmp(k, n.key)
+		if c == 0 {
+			return n.key, n.value, true
+		}
+		if c > 0 {
+			n = n.right
+		} else {
+			found, n = n, n.left
+		}
+	}
+	return nodeEntry(found)
+}
+
+func nodeEntry[K, V any](n *treeNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return n.key, n.value, true
+}
+
+// Rank returns the number of keys less than k.
+func (m *TreeMap[K, V]) Rank(k K) int {
+	rank := 0
+	n := m.root
+	for n != nil {
+		switch c := m.cmp(k, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += n.left.getSize() + 1
+			n = n.right
+		default:
+			return rank + n.left.getSize()
+		}
+	}
+	return rank
+}
+
+// Select returns the entry with the i-th smallest key.
+func (m *TreeMap[K, V]) Select(i int) (K, V, bool) {
+	if i < 0 || i >= m.Len() {
+		return nodeEntry[K, V](nil)
+	}
+	n := m.root
+	for {
+		left := n.left.getSize()
+		switch {
+		case i < left:
+			n = n.left
+		case i > left:
+			i -= left + 1
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+}
+
+func (m *TreeMap[K, V]) Clear() {
+	m.root = nil
+}
+
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.ascend(m.root, nil, nil, yield)
+	}
+}
+
+// Range yields the entries with lo <= key < hi in ascending order.
+func (m *TreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.ascend(m.root, &lo, &hi, yield)
+	}
+}
+
+func (m *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		descend(m.root, yield)
+	}
+}
+
+// ascend walks the subtree in order, skipping keys outside of [lo, hi) when
+// the bounds are set, and reports whether the walk should continue.
+func (m *TreeMap[K, V]) ascend(n *treeNode[K, V], lo, hi *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := lo == nil || m.cmp(n.key, *lo) >= 0
+	belowHi := hi == nil || m.cmp(n.key, *hi) < 0
+	if aboveLo && !m.ascend(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return m.ascend(n.right, lo, hi, yield)
+	}
+	return true
+}
+
+func descend[K, V any](n *treeNode[K, V], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return descend(n.right, yield) && yield(n.key, n.value) && descend(n.left, yield)
+}
+
+// Validate checks the ordering, balance, height and size invariants of the
+// tree.
+func (m *TreeMap[K, V]) Validate() error {
+	_, err := m.validate(m.root, nil, nil)
+	return err
+}
+
+func (m *TreeMap[K, V]) validate(n *treeNode[K, V], lo, hi *K) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if lo != nil && m.cmp(n.key, *lo) <= 0 {
+		return 0, fmt.Errorf("key %v is not greater than %v", n.key, *lo)
+	}
+	if hi != nil && m.cmp(n.key, *hi) >= 0 {
+		return 0, fmt.Errorf("key %v is not less than %v", n.key, *hi)
+	}
+	lh, err := m.validate(n.left, lo, &n.key)
+	if err != nil {
+		return 0, err
+	}
+	rh, err := m.validate(n.right, &n.key, hi)
+	if err != nil {
+		return 0, err
+	}
+	if lh-rh > 1 || rh-lh > 1 {
+		return 0, fmt.Errorf("node %v is unbalanced: heights %d and %d", n.key, lh, rh)
+	}
+	if n.height != 1+max(lh, rh) {
+		return 0, fmt.Errorf("node %v has height %d, want %d", n.key, n.height, 1+max(lh, rh))
+	}
+	if size := 1 + n.left.getSize() + n.right.getSize(); n.size != size {
+		return 0, fmt.Errorf("node %v has size %d, want %d", n.key, n.size, size)
+	}
+	return n.height, nil
+}
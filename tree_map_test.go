@@ -0,0 +1,295 @@
+package ds
+
+import (
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func newTestTreeMap(keys ...int) *TreeMap[int, string] {
+	m := NewTreeMap[int, string]()
+	for _, k := range keys {
+		m.Put(k, strconv.Itoa(k))
+	}
+	return m
+}
+
+func TestTreeMapPutGetDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		init     []int
+		delete   []int
+		wantKeys []int
+		wantDel  []bool
+	}{
+		{
+			name:     "empty",
+			wantKeys: []int{},
+		},
+		{
+			name:     "ascending inserts",
+			init:     []int{1, 2, 3, 4, 5, 6, 7},
+			wantKeys: []int{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			name:     "duplicate keys",
+			init:     []int{3, 1, 3, 2, 1},
+			wantKeys: []int{1, 2, 3},
+		},
+		{
+			name:     "delete leaf, inner and missing",
+			init:     []int{5, 3, 8, 1, 4, 7, 9},
+			delete:   []int{1, 5, 6},
+			wantKeys: []int{3, 4, 7, 8, 9},
+			wantDel:  []bool{true, true, false},
+		},
+		{
+			name:     "delete everything",
+			init:     []int{2, 1, 3},
+			delete:   []int{1, 2, 3, 2},
+			wantKeys: []int{},
+			wantDel:  []bool{true, true, true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestTreeMap(tt.init...)
+			var gotDel []bool
+			for _, k := range tt.delete {
+				gotDel = append(gotDel, m.Delete(k))
+			}
+
+			if !slices.Equal(gotDel, tt.wantDel) {
+				t.Errorf("Delete() results = %v, want %v", gotDel, tt.wantDel)
+			}
+
+			gotKeys := []int{}
+			for k := range m.All() {
+				gotKeys = append(gotKeys, k)
+			}
+			if !slices.Equal(gotKeys, tt.wantKeys) {
+				t.Errorf("All() keys = %v, want %v", gotKeys, tt.wantKeys)
+			}
+
+			if got := m.Len(); got != len(tt.wantKeys) {
+				t.Errorf("Len() = %d, want %d", got, len(tt.wantKeys))
+			}
+
+			for _, k := range tt.wantKeys {
+				if _, ok := m.Get(k); !ok {
+					t.Errorf("Get(%d) not found", k)
+				}
+			}
+			for _, k := range tt.delete {
+				if _, ok := m.Get(k); ok {
+					t.Errorf("Get(%d) found a deleted key", k)
+				}
+			}
+
+			if err := m.Validate(); err != nil {
+				t.Errorf("Validate() = %v", err)
+			}
+		})
+	}
+
+	t.Run("overwrite value", func(t *testing.T) {
+		m := NewTreeMap[string, int]()
+		m.Put("a", 1)
+		m.Put("a", 2)
+		if got, ok := m.Get("a"); got != 2 || !ok {
+			t.Errorf("Get(a) = (%v, %v), want (2, true)", got, ok)
+		}
+	})
+}
+
+func TestTreeMapFloorCeiling(t *testing.T) {
+	tests := []struct {
+		name        string
+		init        []int
+		key         int
+		wantFloor   int
+		wantFloorOk bool
+		wantCeil    int
+		wantCeilOk  bool
+	}{
+		{
+			name: "empty",
+			key:  1,
+		},
+		{
+			name:        "exact match",
+			init:        []int{10, 20, 30},
+			key:         20,
+			wantFloor:   20,
+			wantFloorOk: true,
+			wantCeil:    20,
+			wantCeilOk:  true,
+		},
+		{
+			name:        "between keys",
+			init:        []int{10, 20, 30},
+			key:         25,
+			wantFloor:   20,
+			wantFloorOk: true,
+			wantCeil:    30,
+			wantCeilOk:  true,
+		},
+		{
+			name:       "below minimum",
+			init:       []int{10, 20, 30},
+			key:        5,
+			wantCeil:   10,
+			wantCeilOk: true,
+		},
+		{
+			name:        "above maximum",
+			init:        []int{10, 20, 30},
+			key:         35,
+			wantFloor:   30,
+			wantFloorOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestTreeMap(tt.init...)
+
+			if got, _, ok := m.Floor(tt.key); got != tt.wantFloor || ok != tt.wantFloorOk {
+				t.Errorf("Floor(%d) = (%v, %v), want (%v, %v)", tt.key, got, ok, tt.wantFloor, tt.wantFloorOk)
+			}
+
+			if got, _, ok := m.Ceiling(tt.key); got != tt.wantCeil || ok != tt.wantCeilOk {
+				t.Errorf("Ceiling(%d) = (%v, %v), want (%v, %v)", tt.key, got, ok, tt.wantCeil, tt.wantCeilOk)
+			}
+		})
+	}
+}
+
+func TestTreeMapOrderStatistics(t *testing.T) {
+	m := newTestTreeMap(50, 20, 80, 10, 30, 70, 90)
+
+	if k, _, ok := m.Min(); k != 10 || !ok {
+		t.Errorf("Min() = (%v, %v), want (10, true)", k, ok)
+	}
+	if k, _, ok := m.Max(); k != 90 || !ok {
+		t.Errorf("Max() = (%v, %v), want (90, true)", k, ok)
+	}
+
+	ranks := []struct {
+		key  int
+		want int
+	}{
+		{5, 0}, {10, 0}, {15, 1}, {50, 3}, {75, 5}, {90, 6}, {100, 7},
+	}
+	for _, r := range ranks {
+		if got := m.Rank(r.key); got != r.want {
+			t.Errorf("Rank(%d) = %d, want %d", r.key, got, r.want)
+		}
+	}
+
+	for i, want := range []int{10, 20, 30, 50, 70, 80, 90} {
+		if got, _, ok := m.Select(i); got != want || !ok {
+			t.Errorf("Select(%d) = (%v, %v), want (%v, true)", i, got, ok, want)
+		}
+	}
+	for _, i := range []int{-1, 7} {
+		if _, _, ok := m.Select(i); ok {
+			t.Errorf("Select(%d) ok, want out of range", i)
+		}
+	}
+
+	empty := NewTreeMap[int, int]()
+	if _, _, ok := empty.Min(); ok {
+		t.Error("Min() of empty map ok")
+	}
+	if _, _, ok := empty.Max(); ok {
+		t.Error("Max() of empty map ok")
+	}
+}
+
+func TestTreeMapIterators(t *testing.T) {
+	m := newTestTreeMap(5, 1, 9, 3, 7)
+
+	keys := func(seq iter.Seq2[int, string]) []int {
+		var ks []int
+		for k := range seq {
+			ks = append(ks, k)
+		}
+		return ks
+	}
+
+	tests := []struct {
+		name string
+		seq  iter.Seq2[int, string]
+		want []int
+	}{
+		{"all", m.All(), []int{1, 3, 5, 7, 9}},
+		{"backward", m.Backward(), []int{9, 7, 5, 3, 1}},
+		{"range inner", m.Range(3, 8), []int{3, 5, 7}},
+		{"range excludes hi", m.Range(3, 7), []int{3, 5}},
+		{"range outside", m.Range(10, 20), nil},
+		{"range empty", m.Range(5, 5), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keys(tt.seq); !slices.Equal(got, tt.want) {
+				t.Errorf("keys = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("early stop", func(t *testing.T) {
+		var got []int
+		for k := range m.All() {
+			got = append(got, k)
+			if k == 5 {
+				break
+			}
+		}
+		if want := []int{1, 3, 5}; !slices.Equal(got, want) {
+			t.Errorf("keys before break = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestTreeMapModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	m := NewTreeMap[int, int]()
+	model := map[int]int{}
+
+	for step := range 5000 {
+		k := r.IntN(500)
+		if r.IntN(3) == 0 {
+			_, had := model[k]
+			if got := m.Delete(k); got != had {
+				t.Fatalf("step %d: Delete(%d) = %v, want %v", step, k, got, had)
+			}
+			delete(model, k)
+		} else {
+			m.Put(k, step)
+			model[k] = step
+		}
+
+		if step%100 == 0 {
+			if err := m.Validate(); err != nil {
+				t.Fatalf("step %d: Validate() = %v", step, err)
+			}
+			want := slices.Sorted(maps.Keys(model))
+			var got []int
+			for k, v := range m.All() {
+				if v != model[k] {
+					t.Fatalf("step %d: value of %d = %d, want %d", step, k, v, model[k])
+				}
+				got = append(got, k)
+			}
+			if !slices.Equal(got, want) {
+				t.Fatalf("step %d: keys = %v, want %v", step, got, want)
+			}
+		}
+	}
+}
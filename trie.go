@@ -0,0 +1,155 @@
+package ds
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"unicode/utf8"
+)
+
+type trieNode[V any] struct {
+	children map[rune]*trieNode[V]
+	value    V
+	ok       bool
+}
+
+// Trie maps string keys to values with one node per rune. Keys are split
+// into runes as by a range loop, so invalid UTF-8 bytes all become
+// utf8.RuneError.
+type Trie[V any] struct {
+	root trieNode[V]
+	len  int
+}
+
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+func (t *Trie[V]) Len() int {
+	return t.len
+}
+
+// Insert sets the value of key and reports whether key is new.
+func (t *Trie[V]) Insert(key string, v V) bool {
+	n := &t.root
+	for _, r := range key {
+		c, ok := n.children[r]
+		if !ok {
+			if n.children == nil {
+				n.children = make(map[rune]*trieNode[V])
+			}
+			c = &trieNode[V]{}
+			n.children[r] = c
+		}
+		n = c
+	}
+	n.value = v
+	if n.ok {
+		return false
+	}
+	n.ok = true
+	t.len++
+	return true
+}
+
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	n := &t.root
+	for _, r := range key {
+		n = n.children[r]
+		if n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+func (t *Trie[V]) Get(key string) (V, bool) {
+	n := t.find(key)
+	if n == nil || !n.ok {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Delete removes key and the nodes left without keys below them.
+func (t *Trie[V]) Delete(key string) bool {
+	path := []*trieNode[V]{&t.root}
+	runes := []rune(key)
+	for _, r := range runes {
+		c := path[len(path)-1].children[r]
+		if c == nil {
+			return false
+		}
+		path = append(path, c)
+	}
+	n := path[len(path)-1]
+	if !n.ok {
+		return false
+	}
+	var zero V
+	n.value, n.ok = zero, false
+	t.len--
+
+	for i := len(path) - 1; i > 0; i-- {
+		if path[i].ok || len(path[i].children) > 0 {
+			break
+		}
+		delete(path[i-1].children, runes[i-1])
+	}
+	return true
+}
+
+// LongestPrefix returns the longest key that is a prefix of s.
+func (t *Trie[V]) LongestPrefix(s string) (string, V, bool) {
+	var found *trieNode[V]
+	end := 0
+	n := &t.root
+	if n.ok {
+		found = n
+	}
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		n = n.children[r]
+		if n == nil {
+			break
+		}
+		if n.ok {
+			found, end = n, i
+		}
+	}
+	if found == nil {
+		var zero V
+		return "", zero, false
+	}
+	return s[:end], found.value, true
+}
+
+// WalkPrefix yields the keys starting with prefix and their values in
+// lexicographic order of runes.
+func (t *Trie[V]) WalkPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		n := t.find(prefix)
+		if n == nil {
+			return
+		}
+		n.walk([]rune(prefix), yield)
+	}
+}
+
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return t.WalkPrefix("")
+}
+
+func (n *trieNode[V]) walk(key []rune, yield func(string, V) bool) bool {
+	if n.ok && !yield(string(key), n.value) {
+		return false
+	}
+	for _, r := range slices.Sorted(maps.Keys(n.children)) {
+		if !n.children[r].walk(append(key, r), yield) {
+			return false
+		}
+	}
+	return true
+}
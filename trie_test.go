@@ -0,0 +1,281 @@
+package ds
+
+import (
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+type testPrefixTree interface {
+	Len() int
+	Insert(key string, v int) bool
+	Get(key string) (int, bool)
+	Delete(key string) bool
+	LongestPrefix(s string) (string, int, bool)
+	WalkPrefix(prefix string) iter.Seq2[string, int]
+	All() iter.Seq2[string, int]
+}
+
+var (
+	_ testPrefixTree = (*Trie[int])(nil)
+	_ testPrefixTree = (*RadixTree[int])(nil)
+)
+
+func prefixTreeKeys(seq iter.Seq2[string, int]) []string {
+	ks := []string{}
+	for k := range seq {
+		ks = append(ks, k)
+	}
+	return ks
+}
+
+func newTestPrefixTree[T testPrefixTree](newTree func() T, keys ...string) T {
+	tr := newTree()
+	for i, k := range keys {
+		tr.Insert(k, i)
+	}
+	return tr
+}
+
+var routeKeys = []string{"/", "/api", "/api/users", "/api/user", "/apps", "/static/", "/статус", "/статья", "日本", "日本語"}
+
+func testPrefixTreeInsertGetDelete[T testPrefixTree](t *testing.T, newTree func() T) {
+	tests := []struct {
+		name     string
+		init     []string
+		delete   []string
+		wantKeys []string
+		wantDel  []bool
+	}{
+		{
+			name:     "empty",
+			wantKeys: []string{},
+		},
+		{
+			name:     "sorted by runes",
+			init:     routeKeys,
+			wantKeys: []string{"/", "/api", "/api/user", "/api/users", "/apps", "/static/", "/статус", "/статья", "日本", "日本語"},
+		},
+		{
+			name:     "empty key and duplicates",
+			init:     []string{"b", "", "a", "b"},
+			wantKeys: []string{"", "a", "b"},
+		},
+		{
+			name:     "delete inner, leaf and missing keys",
+			init:     routeKeys,
+			delete:   []string{"/api", "/apps", "/ap", "/api/users/x", "/стат", "日本語"},
+			wantKeys: []string{"/", "/api/user", "/api/users", "/static/", "/статус", "/статья", "日本"},
+			wantDel:  []bool{true, true, false, false, false, true},
+		},
+		{
+			name:     "delete everything",
+			init:     []string{"a", "ab", "abc", ""},
+			delete:   []string{"ab", "", "abc", "a", "a"},
+			wantKeys: []string{},
+			wantDel:  []bool{true, true, true, true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newTestPrefixTree(newTree, tt.init...)
+			var gotDel []bool
+			for _, k := range tt.delete {
+				gotDel = append(gotDel, tr.Delete(k))
+			}
+			if !slices.Equal(gotDel, tt.wantDel) {
+				t.Errorf("Delete() results = %v, want %v", gotDel, tt.wantDel)
+			}
+			if got := prefixTreeKeys(tr.All()); !slices.Equal(got, tt.wantKeys) {
+				t.Errorf("All() keys = %q, want %q", got, tt.wantKeys)
+			}
+			if got := tr.Len(); got != len(tt.wantKeys) {
+				t.Errorf("Len() = %d, want %d", got, len(tt.wantKeys))
+			}
+			for _, k := range tt.wantKeys {
+				if v, ok := tr.Get(k); !ok || tt.init[v] != k {
+					t.Errorf("Get(%q) = (%v, %v), want the index of %q", k, v, ok, k)
+				}
+			}
+			for _, k := range tt.delete {
+				if _, ok := tr.Get(k); ok {
+					t.Errorf("Get(%q) found a deleted key", k)
+				}
+			}
+		})
+	}
+
+	t.Run("insert reports new keys", func(t *testing.T) {
+		tr := newTree()
+		if !tr.Insert("ключ", 1) || tr.Insert("ключ", 2) || !tr.Insert("ключи", 3) {
+			t.Error("Insert() results, want true, false, true")
+		}
+		if v, _ := tr.Get("ключ"); v != 2 {
+			t.Errorf("Get(ключ) = %d, want the overwritten value 2", v)
+		}
+		if _, ok := tr.Get("клю"); ok {
+			t.Error("Get(клю) found a prefix that is not a key")
+		}
+	})
+}
+
+func testPrefixTreeLongestPrefix[T testPrefixTree](t *testing.T, newTree func() T) {
+	tr := newTestPrefixTree(newTree, routeKeys...)
+
+	tests := []struct {
+		s      string
+		want   string
+		wantOk bool
+	}{
+		{"/api/users/42", "/api/users", true},
+		{"/api/use", "/api", true},
+		{"/api", "/api", true},
+		{"/appsx", "/apps", true},
+		{"/статусы", "/статус", true},
+		{"/стат", "/", true},
+		{"日本語です", "日本語", true},
+		{"日本人", "日本", true},
+		{"日", "", false},
+		{"api", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		got, v, ok := tr.LongestPrefix(tt.s)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("LongestPrefix(%q) = (%q, %v), want (%q, %v)", tt.s, got, ok, tt.want, tt.wantOk)
+		}
+		if ok && routeKeys[v] != got {
+			t.Errorf("LongestPrefix(%q) value = %d, want the index of %q", tt.s, v, got)
+		}
+	}
+
+	tr.Insert("", -1)
+	if got, v, ok := tr.LongestPrefix("x"); got != "" || v != -1 || !ok {
+		t.Errorf("LongestPrefix(x) with an empty key = (%q, %v, %v), want (\"\", -1, true)", got, v, ok)
+	}
+}
+
+func testPrefixTreeWalkPrefix[T testPrefixTree](t *testing.T, newTree func() T) {
+	tr := newTestPrefixTree(newTree, routeKeys...)
+
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"/api", []string{"/api", "/api/user", "/api/users"}},
+		{"/api/u", []string{"/api/user", "/api/users"}},
+		{"/ap", []string{"/api", "/api/user", "/api/users", "/apps"}},
+		{"/ст", []string{"/статус", "/статья"}},
+		{"/стату", []string{"/статус"}},
+		{"日", []string{"日本", "日本語"}},
+		{"/apx", []string{}},
+		{"/api/users/", []string{}},
+	}
+
+	for _, tt := range tests {
+		if got := prefixTreeKeys(tr.WalkPrefix(tt.prefix)); !slices.Equal(got, tt.want) {
+			t.Errorf("WalkPrefix(%q) = %q, want %q", tt.prefix, got, tt.want)
+		}
+	}
+
+	var got []string
+	for k := range tr.WalkPrefix("/") {
+		got = append(got, k)
+		if len(got) == 3 {
+			break
+		}
+	}
+	if want := []string{"/", "/api", "/api/user"}; !slices.Equal(got, want) {
+		t.Errorf("keys before break = %q, want %q", got, want)
+	}
+}
+
+func testPrefixTreeModel[T testPrefixTree](t *testing.T, newTree func() T) {
+	r := rand.New(rand.NewPCG(11, 12))
+	alphabet := []rune("abé日")
+	randKey := func() string {
+		k := make([]rune, r.IntN(5))
+		for i := range k {
+			k[i] = alphabet[r.IntN(len(alphabet))]
+		}
+		return string(k)
+	}
+
+	tr := newTree()
+	model := map[string]int{}
+	for step := range 5000 {
+		k := randKey()
+		if r.IntN(3) == 0 {
+			_, had := model[k]
+			if got := tr.Delete(k); got != had {
+				t.Fatalf("step %d: Delete(%q) = %v, want %v", step, k, got, had)
+			}
+			delete(model, k)
+		} else {
+			_, had := model[k]
+			if got := tr.Insert(k, step); got == had {
+				t.Fatalf("step %d: Insert(%q) = %v, want %v", step, k, got, !had)
+			}
+			model[k] = step
+		}
+
+		if step%250 == 0 {
+			want := slices.Sorted(maps.Keys(model))
+			if got := prefixTreeKeys(tr.All()); !slices.Equal(got, want) {
+				t.Fatalf("step %d: keys = %q, want %q", step, got, want)
+			}
+			for k, v := range tr.All() {
+				if v != model[k] {
+					t.Fatalf("step %d: value of %q = %d, want %d", step, k, v, model[k])
+				}
+			}
+		}
+	}
+}
+
+func TestTrieInsertGetDelete(t *testing.T) {
+	testPrefixTreeInsertGetDelete(t, NewTrie[int])
+}
+
+func TestTrieLongestPrefix(t *testing.T) {
+	testPrefixTreeLongestPrefix(t, NewTrie[int])
+}
+
+func TestTrieWalkPrefix(t *testing.T) {
+	testPrefixTreeWalkPrefix(t, NewTrie[int])
+}
+
+func TestTrieModel(t *testing.T) {
+	testPrefixTreeModel(t, NewTrie[int])
+}
+
+func TestTrieDeletePrunes(t *testing.T) {
+	tr := NewTrie[int]()
+	tr.Insert("abc", 1)
+	tr.Insert("abd", 2)
+	tr.Delete("abc")
+	tr.Delete("abd")
+	if len(tr.root.children) != 0 {
+		t.Errorf("root keeps %d children after every key was deleted", len(tr.root.children))
+	}
+}
+
+func benchmarkPrefixTreeGet[T testPrefixTree](b *testing.B, newTree func() T) {
+	tr := newTree()
+	keys := make([]string, 10000)
+	for i := range keys {
+		keys[i] = "/api/v1/resource/" + string(rune('a'+i%26)) + "/" + string(rune('α'+i%24)) + "/" + string(rune(i))
+		tr.Insert(keys[i], i)
+	}
+	for i := 0; b.Loop(); i++ {
+		tr.Get(keys[i%len(keys)])
+	}
+}
+
+func BenchmarkTrieGet(b *testing.B) {
+	benchmarkPrefixTreeGet(b, NewTrie[int])
+}
@@ -0,0 +1,194 @@
+package ds
+
+import "iter"
+
+// UnionFind keeps a partition of the IDs 0..Len()-1 into disjoint sets. It
+// links the smaller set under the larger one and halves the paths it walks,
+// so its operations take amortized near-constant time.
+type UnionFind struct {
+	parent []int
+	size   []int
+	sets   int
+}
+
+// NewUnionFind puts each of the IDs 0..n-1 into its own set.
+func NewUnionFind(n int) *UnionFind {
+	u := &UnionFind{}
+	u.Grow(n)
+	return u
+}
+
+func (u *UnionFind) Len() int {
+	return len(u.parent)
+}
+
+// Sets returns the number of disjoint sets.
+func (u *UnionFind) Sets() int {
+	return u.sets
+}
+
+// Grow adds n new IDs, each in its own set.
+func (u *UnionFind) Grow(n int) {
+	for range n {
+		u.parent = append(u.parent, len(u.parent))
+		u.size = append(u.size, 1)
+	}
+	u.sets += max(n, 0)
+}
+
+// Add adds a new ID in its own set and returns it.
+func (u *UnionFind) Add() int {
+	u.Grow(1)
+	return len(u.parent) - 1
+}
+
+// Find returns the representative of the set of x.
+func (u *UnionFind) Find(x int) int {
+	for u.parent[x] != x {
+		u.parent[x] = u.parent[u.parent[x]]
+		x = u.parent[x]
+	}
+	return x
+}
+
+// Union merges the sets of a and b and reports whether they were different.
+func (u *UnionFind) Union(a, b int) bool {
+	ra, rb := u.Find(a), u.Find(b)
+	if ra == rb {
+		return false
+	}
+	if u.size[ra] < u.size[rb] {
+		ra, rb = rb, ra
+	}
+	u.parent[rb] = ra
+	u.size[ra] += u.size[rb]
+	u.sets--
+	return true
+}
+
+func (u *UnionFind) Connected(a, b int) bool {
+	return u.Find(a) == u.Find(b)
+}
+
+// SetSize returns the number of IDs in the set of x.
+func (u *UnionFind) SetSize(x int) int {
+	return u.size[u.Find(x)]
+}
+
+// Groups yields the sets with their IDs in ascending order, ordered by their
+// smallest ID.
+func (u *UnionFind) Groups() iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		index := make(map[int]int, u.sets)
+		groups := make([][]int, 0, u.sets)
+		for x := range u.parent {
+			r := u.Find(x)
+			i, ok := index[r]
+			if !ok {
+				i = len(groups)
+				index[r] = i
+				groups = append(groups, make([]int, 0, u.size[r]))
+			}
+			groups[i] = append(groups[i], x)
+		}
+		for _, g := range groups {
+			if !yield(g) {
+				return
+			}
+		}
+	}
+}
+
+// KeyedUnionFind is a UnionFind over arbitrary keys. Union adds the keys it
+// has not seen yet.
+type KeyedUnionFind[E comparable] struct {
+	u    UnionFind
+	ids  map[E]int
+	keys []E
+}
+
+// NewKeyedUnionFind puts each of vs into its own set.
+func NewKeyedUnionFind[E comparable](vs ...E) *KeyedUnionFind[E] {
+	k := &KeyedUnionFind[E]{ids: make(map[E]int, len(vs))}
+	for _, v := range vs {
+		k.Add(v)
+	}
+	return k
+}
+
+func (k *KeyedUnionFind[E]) Len() int {
+	return len(k.keys)
+}
+
+func (k *KeyedUnionFind[E]) Sets() int {
+	return k.u.Sets()
+}
+
+// Add puts v into its own set and reports whether it was not known yet.
+func (k *KeyedUnionFind[E]) Add(v E) bool {
+	if _, ok := k.ids[v]; ok {
+		return false
+	}
+	k.ids[v] = k.u.Add()
+	k.keys = append(k.keys, v)
+	return true
+}
+
+func (k *KeyedUnionFind[E]) Contains(v E) bool {
+	_, ok := k.ids[v]
+	return ok
+}
+
+func (k *KeyedUnionFind[E]) id(v E) int {
+	k.Add(v)
+	return k.ids[v]
+}
+
+// Find returns the representative of the set of v, or false if v is not
+// known.
+func (k *KeyedUnionFind[E]) Find(v E) (E, bool) {
+	id, ok := k.ids[v]
+	if !ok {
+		var zero E
+		return zero, false
+	}
+	return k.keys[k.u.Find(id)], true
+}
+
+// Union merges the sets of a and b and reports whether they were different.
+func (k *KeyedUnionFind[E]) Union(a, b E) bool {
+	return k.u.Union(k.id(a), k.id(b))
+}
+
+// Connected reports whether a and b are known and in the same set.
+func (k *KeyedUnionFind[E]) Connected(a, b E) bool {
+	ia, okA := k.ids[a]
+	ib, okB := k.ids[b]
+	return okA && okB && k.u.Connected(ia, ib)
+}
+
+// SetSize returns the number of keys in the set of v, or 0 if v is not
+// known.
+func (k *KeyedUnionFind[E]) SetSize(v E) int {
+	id, ok := k.ids[v]
+	if !ok {
+		return 0
+	}
+	return k.u.SetSize(id)
+}
+
+// Groups yields the sets with their keys in the order they were added,
+// ordered by their first added key.
+func (k *KeyedUnionFind[E]) Groups() iter.Seq[[]E] {
+	return func(yield func([]E) bool) {
+		for ids := range k.u.Groups() {
+			g := make([]E, len(ids))
+			for i, id := range ids {
+				g[i] = k.keys[id]
+			}
+			if !yield(g) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package ds
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestUnionFind(t *testing.T) {
+	tests := []struct {
+		name       string
+		n          int
+		unions     [][2]int
+		wantMerged []bool
+		wantGroups [][]int
+	}{
+		{
+			name:       "empty",
+			wantGroups: nil,
+		},
+		{
+			name:       "singletons",
+			n:          3,
+			wantGroups: [][]int{{0}, {1}, {2}},
+		},
+		{
+			name:       "chain",
+			n:          5,
+			unions:     [][2]int{{0, 1}, {1, 2}, {3, 4}},
+			wantMerged: []bool{true, true, true},
+			wantGroups: [][]int{{0, 1, 2}, {3, 4}},
+		},
+		{
+			name:       "repeated and self unions",
+			n:          4,
+			unions:     [][2]int{{3, 1}, {1, 3}, {2, 2}, {1, 0}},
+			wantMerged: []bool{true, false, false, true},
+			wantGroups: [][]int{{0, 1, 3}, {2}},
+		},
+		{
+			name:       "everything",
+			n:          4,
+			unions:     [][2]int{{0, 3}, {1, 2}, {2, 3}},
+			wantMerged: []bool{true, true, true},
+			wantGroups: [][]int{{0, 1, 2, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := NewUnionFind(tt.n)
+			var merged []bool
+			for _, p := range tt.unions {
+				merged = append(merged, u.Union(p[0], p[1]))
+			}
+			if !slices.Equal(merged, tt.wantMerged) {
+				t.Errorf("Union() results = %v, want %v", merged, tt.wantMerged)
+			}
+
+			groups := slices.Collect(u.Groups())
+			if !slices.EqualFunc(groups, tt.wantGroups, slices.Equal) {
+				t.Errorf("Groups() = %v, want %v", groups, tt.wantGroups)
+			}
+			if u.Sets() != len(tt.wantGroups) {
+				t.Errorf("Sets() = %d, want %d", u.Sets(), len(tt.wantGroups))
+			}
+			for _, g := range tt.wantGroups {
+				for _, x := range g {
+					if !u.Connected(x, g[0]) {
+						t.Errorf("Connected(%d, %d) = false, want true", x, g[0])
+					}
+					if got := u.SetSize(x); got != len(g) {
+						t.Errorf("SetSize(%d) = %d, want %d", x, got, len(g))
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("add", func(t *testing.T) {
+		u := NewUnionFind(2)
+		if id := u.Add(); id != 2 {
+			t.Errorf("Add() = %d, want 2", id)
+		}
+		u.Union(0, 2)
+		if u.Connected(0, 1) || !u.Connected(2, 0) || u.Len() != 3 || u.Sets() != 2 {
+			t.Errorf("Groups() after Add() and Union(0, 2) = %v", slices.Collect(u.Groups()))
+		}
+	})
+}
+
+func TestUnionFindModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(13, 14))
+	const n = 300
+	u := NewUnionFind(n)
+	label := make([]int, n)
+	for i := range label {
+		label[i] = i
+	}
+
+	for step := range 400 {
+		a, b := r.IntN(n), r.IntN(n)
+		want := label[a] != label[b]
+		if got := u.Union(a, b); got != want {
+			t.Fatalf("step %d: Union(%d, %d) = %v, want %v", step, a, b, got, want)
+		}
+		old := label[b]
+		for i := range label {
+			if label[i] == old {
+				label[i] = label[a]
+			}
+		}
+
+		x, y := r.IntN(n), r.IntN(n)
+		if got := u.Connected(x, y); got != (label[x] == label[y]) {
+			t.Fatalf("step %d: Connected(%d, %d) = %v, want %v", step, x, y, got, !got)
+		}
+	}
+}
+
+func TestKeyedUnionFind(t *testing.T) {
+	k := NewKeyedUnionFind("a", "b")
+	if k.Add("a") || !k.Add("c") {
+		t.Error("Add(a), Add(c), want false then true")
+	}
+	if !k.Union("a", "c") || !k.Union("d", "e") || k.Union("c", "a") {
+		t.Error("Union() results, want true, true, false")
+	}
+
+	want := [][]string{{"a", "c"}, {"b"}, {"d", "e"}}
+	if got := slices.Collect(k.Groups()); !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("Groups() = %v, want %v", got, want)
+	}
+	if k.Len() != 5 || k.Sets() != 3 {
+		t.Errorf("Len(), Sets() = %d, %d, want 5, 3", k.Len(), k.Sets())
+	}
+
+	if r, ok := k.Find("c"); !ok || r != "a" && r != "c" {
+		t.Errorf("Find(c) = (%v, %v), want a or c", r, ok)
+	}
+	if _, ok := k.Find("x"); ok {
+		t.Error("Find(x) of an unknown key succeeded")
+	}
+	if !k.Connected("e", "d") || k.Connected("a", "b") || k.Connected("x", "x") {
+		t.Error("Connected() results, want only known keys of one set connected")
+	}
+	if k.SetSize("c") != 2 || k.SetSize("x") != 0 || k.Contains("x") {
+		t.Errorf("SetSize(c), SetSize(x) = %d, %d, want 2, 0", k.SetSize("c"), k.SetSize("x"))
+	}
+}
+
+const unionFindBenchSize = 1 << 20
+
+func BenchmarkUnionFindUnion(b *testing.B) {
+	pairs := make([][2]int, unionFindBenchSize)
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := range pairs {
+		pairs[i] = [2]int{r.IntN(unionFindBenchSize), r.IntN(unionFindBenchSize)}
+	}
+	for b.Loop() {
+		u := NewUnionFind(unionFindBenchSize)
+		for _, p := range pairs {
+			u.Union(p[0], p[1])
+		}
+	}
+}
+
+func BenchmarkUnionFindFind(b *testing.B) {
+	u := NewUnionFind(unionFindBenchSize)
+	// unions along a path merge every ID into one set
+	for i := 1; i < unionFindBenchSize; i++ {
+		u.Union(i-1, i)
+	}
+	for i := 0; b.Loop(); i++ {
+		u.Find(i * 7919 % unionFindBenchSize)
+	}
+}
+
+func BenchmarkKeyedUnionFindUnion(b *testing.B) {
+	keys := make([]string, unionFindBenchSize)
+	for i := range keys {
+		keys[i] = "k" + strconv.Itoa(i)
+	}
+	for b.Loop() {
+		k := NewKeyedUnionFind[string]()
+		for i := 1; i < len(keys); i += 2 {
+			k.Union(keys[i-1], keys[i])
+		}
+	}
+}